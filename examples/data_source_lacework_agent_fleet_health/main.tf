@@ -0,0 +1,34 @@
+terraform {
+  required_providers {
+    lacework = {
+      source = "lacework/lacework"
+    }
+  }
+}
+
+provider "lacework" {}
+
+data "lacework_agent_fleet_health" "fleet" {
+  inactive_threshold_days = var.inactive_threshold_days
+}
+
+output "total_agents" {
+  value = data.lacework_agent_fleet_health.fleet.total_agents
+}
+
+output "active_agents" {
+  value = data.lacework_agent_fleet_health.fleet.active_agents
+}
+
+output "inactive_agents" {
+  value = data.lacework_agent_fleet_health.fleet.inactive_agents
+}
+
+output "version_distribution" {
+  value = data.lacework_agent_fleet_health.fleet.version_distribution
+}
+
+variable "inactive_threshold_days" {
+  type    = number
+  default = 7
+}
@@ -0,0 +1,32 @@
+terraform {
+  required_providers {
+    lacework = {
+      source = "lacework/lacework"
+    }
+  }
+}
+
+provider "lacework" {}
+
+data "lacework_events" "recent" {
+  time_window = var.time_window
+  severities  = var.severities
+}
+
+output "event_ids" {
+  value = data.lacework_events.recent.event_ids
+}
+
+output "severities" {
+  value = distinct([for event in data.lacework_events.recent.events : event.severity])
+}
+
+variable "time_window" {
+  type    = string
+  default = "168h"
+}
+
+variable "severities" {
+  type    = list(string)
+  default = ["critical", "high"]
+}
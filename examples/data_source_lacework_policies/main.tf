@@ -0,0 +1,38 @@
+terraform {
+  required_providers {
+    lacework = {
+      source = "lacework/lacework"
+    }
+  }
+}
+
+provider "lacework" {}
+
+data "lacework_policies" "critical" {
+  severities = var.severities
+  state      = var.state
+  source     = var.policy_source
+}
+
+output "policy_ids" {
+  value = data.lacework_policies.critical.policy_ids
+}
+
+output "severities" {
+  value = distinct([for policy in data.lacework_policies.critical.policies : policy.severity])
+}
+
+variable "severities" {
+  type    = list(string)
+  default = ["critical", "high"]
+}
+
+variable "state" {
+  type    = string
+  default = "enabled"
+}
+
+variable "policy_source" {
+  type    = string
+  default = "builtin"
+}
@@ -0,0 +1,26 @@
+terraform {
+  required_providers {
+    lacework = {
+      source = "lacework/lacework"
+    }
+  }
+}
+
+provider "lacework" {}
+
+data "lacework_queries" "builtin" {
+  source = var.query_source
+}
+
+output "query_ids" {
+  value = data.lacework_queries.builtin.query_ids
+}
+
+output "custom_queries" {
+  value = length([for query in data.lacework_queries.builtin.queries : query if query.custom])
+}
+
+variable "query_source" {
+  type    = string
+  default = "builtin"
+}
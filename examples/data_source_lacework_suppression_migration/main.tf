@@ -0,0 +1,55 @@
+terraform {
+  required_providers {
+    lacework = {
+      source = "lacework/lacework"
+    }
+  }
+}
+
+provider "lacework" {}
+
+data "lacework_suppression_migration" "aws" {
+  cloud_types = var.cloud_types
+}
+
+// Uncomment the following resource to create the policy
+// exceptions that are equivalent to the legacy suppressions
+//
+// resource "lacework_policy_exception" "migrated" {
+//   for_each = {
+//     for i, e in data.lacework_suppression_migration.aws.policy_exceptions :
+//     "${e.policy_id}-${i}" => e
+//   }
+//
+//   policy_id   = each.value.policy_id
+//   description = each.value.description
+//
+//   dynamic "constraint" {
+//     for_each = each.value.constraint
+//     content {
+//       field_key    = constraint.value.field_key
+//       field_values = constraint.value.field_values
+//
+//       dynamic "field_value_map" {
+//         for_each = constraint.value.field_value_map
+//         content {
+//           key   = field_value_map.value.key
+//           value = field_value_map.value.value
+//         }
+//       }
+//     }
+//   }
+// }
+
+output "policy_exceptions" {
+  value = data.lacework_suppression_migration.aws.policy_exceptions
+}
+
+output "unmapped_suppressions" {
+  value = data.lacework_suppression_migration.aws.unmapped_suppressions
+}
+
+variable "cloud_types" {
+  type    = list(string)
+  default = ["aws"]
+}
@@ -13,3 +13,7 @@ data "lacework_user_profile" "test" {}
 output "lacework_user_profile_url" {
   value = data.lacework_user_profile.test.url
 }
+
+output "lacework_user_profile_org_account" {
+  value = data.lacework_user_profile.test.org_account
+}
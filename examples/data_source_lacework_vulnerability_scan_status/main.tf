@@ -0,0 +1,37 @@
+terraform {
+  required_providers {
+    lacework = {
+      source = "lacework/lacework"
+    }
+  }
+}
+
+provider "lacework" {}
+
+data "lacework_vulnerability_scan_status" "scan" {
+  request_id = var.request_id
+}
+
+output "status" {
+  value = data.lacework_vulnerability_scan_status.scan.status
+}
+
+output "completed" {
+  value = data.lacework_vulnerability_scan_status.scan.completed
+}
+
+output "highest_severity" {
+  value = data.lacework_vulnerability_scan_status.scan.highest_severity
+}
+
+output "total_vulnerabilities" {
+  value = data.lacework_vulnerability_scan_status.scan.total_vulnerabilities
+}
+
+output "critical_fixable_vulnerabilities" {
+  value = one(data.lacework_vulnerability_scan_status.scan.vulnerability_counts[*].critical_fixable)
+}
+
+variable "request_id" {
+  type = string
+}
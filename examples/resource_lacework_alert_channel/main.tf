@@ -0,0 +1,38 @@
+terraform {
+  required_providers {
+    lacework = {
+      source = "lacework/lacework"
+    }
+  }
+}
+
+provider "lacework" {}
+
+variable "channel_name" {
+  type    = string
+  default = "Generic Alert Channel Example"
+}
+
+resource "lacework_alert_channel" "example" {
+  name = var.channel_name
+  type = "EmailUser"
+  data = jsonencode({
+    channelProps = {
+      recipients = ["foo@example.com"]
+    }
+  })
+
+  // test_integration input is used in this example only for testing
+  // purposes, it help us avoid sending a "test" request to the
+  // system we are integrating to. In production, this should remain
+  // turned on ("true") which is the default setting
+  test_integration = false
+}
+
+output "channel_name" {
+  value = lacework_alert_channel.example.name
+}
+
+output "intg_guid" {
+  value = lacework_alert_channel.example.intg_guid
+}
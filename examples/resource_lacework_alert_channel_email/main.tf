@@ -23,3 +23,11 @@ resource "lacework_alert_channel_email" "example" {
   // turned on ("true") which is the default setting
   test_integration = false
 }
+
+output "channel_name" {
+  value = lacework_alert_channel_email.example.name
+}
+
+output "intg_guid" {
+  value = lacework_alert_channel_email.example.intg_guid
+}
@@ -0,0 +1,37 @@
+provider "lacework" {}
+
+resource "lacework_cloud_account" "example" {
+  name = var.name
+  type = "AwsCfg"
+  data = jsonencode({
+    crossAccountCredentials = {
+      roleArn    = var.role_arn
+      externalId = var.external_id
+    }
+  })
+
+  retries = 10
+}
+
+variable "name" {
+  type    = string
+  default = "AWS config integration example"
+}
+
+variable "role_arn" {
+  type    = string
+  default = "arn:aws:iam::1234567890:role/lacework_iam_example_role"
+}
+
+variable "external_id" {
+  type    = string
+  default = "12345"
+}
+
+output "name" {
+  value = lacework_cloud_account.example.name
+}
+
+output "intg_guid" {
+  value = lacework_cloud_account.example.intg_guid
+}
@@ -0,0 +1,28 @@
+provider "lacework" {}
+
+resource "lacework_integration_aws_org_cfg" "example" {
+  name        = var.name
+  role_arns   = var.role_arns
+  external_id = var.external_id
+
+  retries = 10
+}
+
+variable "name" {
+  type    = string
+  default = "AWS config org integration example"
+}
+
+variable "role_arns" {
+  type    = list(string)
+  default = ["arn:aws:iam::123456789012:role/lacework_iam_example_role"]
+}
+
+variable "external_id" {
+  type    = string
+  default = "12345"
+}
+
+output "intg_guids" {
+  value = lacework_integration_aws_org_cfg.example.intg_guids
+}
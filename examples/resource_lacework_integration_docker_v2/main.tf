@@ -9,6 +9,7 @@ resource "lacework_integration_docker_v2" "example" {
   ssl                    = true
   notifications          = true
   limit_by_tags          = ["dev*", "*test"]
+  limit_by_repositories  = ["team/app", "team/worker"]
 
   limit_by_label {
     key   = "key"
@@ -9,6 +9,8 @@ terraform {
 resource "lacework_policy_exception" "example" {
   policy_id   = "lacework-global-39"
   description = var.description
+  approved_by = var.approved_by
+  ticket_ref  = var.ticket_ref
   constraint {
     field_key    = var.field_key
     field_values = ["*"]
@@ -41,6 +43,16 @@ variable "description" {
   default = "Policy Exception Created via Terraform"
 }
 
+variable "approved_by" {
+  type    = string
+  default = ""
+}
+
+variable "ticket_ref" {
+  type    = string
+  default = ""
+}
+
 output "description" {
   value = lacework_policy_exception.example.description
 }
@@ -48,3 +60,11 @@ output "description" {
 output "policy_id" {
   value = lacework_policy_exception.example.policy_id
 }
+
+output "approved_by" {
+  value = lacework_policy_exception.example.approved_by
+}
+
+output "ticket_ref" {
+  value = lacework_policy_exception.example.ticket_ref
+}
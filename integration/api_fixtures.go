@@ -0,0 +1,81 @@
+package integration
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/lacework/go-sdk/api"
+
+	"github.com/lacework/terraform-provider-lacework/lacework"
+)
+
+// the integration tests that have recorded API fixtures can run without a Lacework
+// account when LW_API_FIXTURES_MODE is 'replay', or record them when it is 'record'
+const (
+	apiFixturesModeEnv    = "LW_API_FIXTURES_MODE"
+	apiFixturesModeRecord = "record"
+	apiFixturesModeReplay = "replay"
+	apiFixturesDir        = "fixtures"
+
+	// the access token used when the fixtures are replayed
+	apiFixturesToken = "_mock_token"
+)
+
+func apiFixturesMode() string {
+	return strings.ToLower(os.Getenv(apiFixturesModeEnv))
+}
+
+// withApiFixtures configures the test to replay, or record, the API fixtures of the file
+// 'fixtures/<test name>.json'. Both the provider and the go-sdk client of the test go
+// through the fixtures, it returns the environment variables of the Terraform runs
+func withApiFixtures(t *testing.T, envVars map[string]string) map[string]string {
+	mode := apiFixturesMode()
+	if mode != apiFixturesModeRecord && mode != apiFixturesModeReplay {
+		return envVars
+	}
+
+	path, err := filepath.Abs(filepath.Join(apiFixturesDir, t.Name()+".json"))
+	if err != nil {
+		t.Fatalf("Unable to find the API fixtures of %s: %v", t.Name(), err)
+	}
+
+	// the replay state is shared by the Terraform runs of the test only
+	state := path + ".state"
+	os.Remove(state)
+	t.Cleanup(func() { os.Remove(state) })
+
+	var client *api.Client
+	if mode == apiFixturesModeRecord {
+		os.Remove(path)
+		client, err = api.NewClient(os.Getenv("LW_ACCOUNT"),
+			api.WithApiKeys(os.Getenv("LW_API_KEY"), os.Getenv("LW_API_SECRET")),
+			api.WithSubaccount(os.Getenv("LW_SUBACCOUNT")),
+			api.WithApiV2(),
+			api.WithTransport(lacework.ApiFixtureTransport(path, true)),
+		)
+	} else {
+		if _, err := os.Stat(path); err != nil {
+			t.Skipf("No recorded API fixtures for %s", t.Name())
+		}
+		client, err = api.NewClient("mock",
+			api.WithToken(apiFixturesToken),
+			api.WithApiV2(),
+			api.WithTransport(lacework.ApiFixtureTransport(path, false)),
+		)
+	}
+	if err != nil {
+		t.Fatalf("Failed to create new go-sdk client, %v", err)
+	}
+
+	previous := LwClient
+	LwClient = client
+	t.Cleanup(func() { LwClient = previous })
+
+	vars := map[string]string{"LW_API_FIXTURES": path}
+	for key, value := range envVars {
+		vars[key] = value
+	}
+	return vars
+}
@@ -0,0 +1,29 @@
+package integration
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestAgentFleetHealthDataSource uses the Terraform plan at:
+// => '../examples/data_source_lacework_agent_fleet_health'
+func TestAgentFleetHealthDataSource(t *testing.T) {
+	terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+		TerraformDir: "../examples/data_source_lacework_agent_fleet_health",
+	})
+	defer terraform.Destroy(t, terraformOptions)
+
+	terraform.InitAndApplyAndIdempotent(t, terraformOptions)
+
+	total, err := strconv.Atoi(terraform.Output(t, terraformOptions, "total_agents"))
+	assert.Nil(t, err)
+	active, err := strconv.Atoi(terraform.Output(t, terraformOptions, "active_agents"))
+	assert.Nil(t, err)
+	inactive, err := strconv.Atoi(terraform.Output(t, terraformOptions, "inactive_agents"))
+	assert.Nil(t, err)
+
+	assert.LessOrEqual(t, active+inactive, total)
+}
@@ -0,0 +1,25 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestEventsDataSource uses the Terraform plan at:
+// => '../examples/data_source_lacework_events'
+func TestEventsDataSource(t *testing.T) {
+	terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+		TerraformDir: "../examples/data_source_lacework_events",
+	})
+	defer terraform.Destroy(t, terraformOptions)
+
+	// the time range is relative to now, new events make the plan not idempotent
+	terraform.InitAndApply(t, terraformOptions)
+
+	severities := terraform.OutputList(t, terraformOptions, "severities")
+	for _, severity := range severities {
+		assert.Contains(t, []string{"critical", "high"}, severity)
+	}
+}
@@ -0,0 +1,27 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestPoliciesDataSource uses the Terraform plan at:
+// => '../examples/data_source_lacework_policies'
+func TestPoliciesDataSource(t *testing.T) {
+	terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+		TerraformDir: "../examples/data_source_lacework_policies",
+	})
+	defer terraform.Destroy(t, terraformOptions)
+
+	terraform.InitAndApplyAndIdempotent(t, terraformOptions)
+
+	policyIDs := terraform.OutputList(t, terraformOptions, "policy_ids")
+	assert.NotEmpty(t, policyIDs)
+
+	severities := terraform.OutputList(t, terraformOptions, "severities")
+	for _, severity := range severities {
+		assert.Contains(t, []string{"critical", "high"}, severity)
+	}
+}
@@ -0,0 +1,23 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestQueriesDataSource uses the Terraform plan at:
+// => '../examples/data_source_lacework_queries'
+func TestQueriesDataSource(t *testing.T) {
+	terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+		TerraformDir: "../examples/data_source_lacework_queries",
+	})
+	defer terraform.Destroy(t, terraformOptions)
+
+	terraform.InitAndApplyAndIdempotent(t, terraformOptions)
+
+	queryIDs := terraform.OutputList(t, terraformOptions, "query_ids")
+	assert.NotEmpty(t, queryIDs)
+	assert.Equal(t, "0", terraform.Output(t, terraformOptions, "custom_queries"))
+}
@@ -0,0 +1,27 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestSuppressionMigrationDataSource uses the Terraform plan at:
+// => '../examples/data_source_lacework_suppression_migration'
+func TestSuppressionMigrationDataSource(t *testing.T) {
+	terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+		TerraformDir: "../examples/data_source_lacework_suppression_migration",
+		EnvVars:      tokenEnvVar,
+	})
+	defer terraform.Destroy(t, terraformOptions)
+
+	terraform.InitAndApplyAndIdempotent(t, terraformOptions)
+
+	exceptions := terraform.OutputListOfObjects(t, terraformOptions, "policy_exceptions")
+	for _, exception := range exceptions {
+		assert.NotEmpty(t, exception["policy_id"])
+		assert.Equal(t, "aws", exception["cloud_type"])
+		assert.NotEmpty(t, exception["constraint"])
+	}
+}
@@ -0,0 +1,33 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestVulnerabilityScanStatusDataSource uses the Terraform plan at:
+// => '../examples/data_source_lacework_vulnerability_scan_status'
+//
+// It uses the go-sdk to trigger an on-demand container vulnerability
+// scan and reads its status from the returned request ID
+func TestVulnerabilityScanStatusDataSource(t *testing.T) {
+	scan, err := LwClient.V2.Vulnerabilities.Containers.Scan("index.docker.io", "lacework/lacework-cli", "latest")
+	require.NoError(t, err, "unable to trigger container vulnerability scan")
+	require.NotEmpty(t, scan.Data.RequestID)
+
+	terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+		TerraformDir: "../examples/data_source_lacework_vulnerability_scan_status",
+		Vars: map[string]interface{}{
+			"request_id": scan.Data.RequestID,
+		},
+	})
+	defer terraform.Destroy(t, terraformOptions)
+
+	terraform.InitAndApply(t, terraformOptions)
+
+	assert.NotEmpty(t, terraform.Output(t, terraformOptions, "status"))
+	assert.Contains(t, []string{"true", "false"}, terraform.Output(t, terraformOptions, "completed"))
+}
@@ -16,6 +16,15 @@ var (
 )
 
 func init() {
+	// the tests that replay API fixtures configure their own client
+	if apiFixturesMode() == apiFixturesModeReplay {
+		LwApiToken = apiFixturesToken
+		tokenEnvVar = map[string]string{
+			"LW_API_TOKEN": LwApiToken,
+		}
+		return
+	}
+
 	LwClient = lwTestCLient()
 	LwOrgClient = lwOrgTestClient()
 	LwApiToken = generateUniqueApiToken()
@@ -0,0 +1,124 @@
+//go:build acceptance_matrix
+
+package matrix
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/lacework/go-sdk/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// runMatrix runs the provided test function against every selected target,
+// targets run sequentially since they share the same Terraform plans
+func runMatrix(t *testing.T, fn func(*testing.T, target)) {
+	targets, err := selectedTargets()
+	require.NoError(t, err)
+
+	if len(targets) == 0 {
+		t.Skipf("no acceptance test matrix targets configured, use %s to select targets", targetsEnvVar)
+	}
+
+	for _, tgt := range targets {
+		tgt := tgt
+		t.Run(tgt.Name(), func(t *testing.T) {
+			fn(t, tgt)
+		})
+	}
+}
+
+func lwTargetClient(t *testing.T, tgt target) *api.Client {
+	opts := []api.Option{
+		api.WithApiKeys(tgt.ApiKey, tgt.ApiSecret),
+		api.WithSubaccount(tgt.Subaccount),
+		api.WithApiV2(),
+	}
+	if tgt.Org {
+		opts = append(opts, api.WithOrgAccess())
+	}
+
+	lw, err := api.NewClient(tgt.Account, opts...)
+	require.NoError(t, err, "failed to create new go-sdk client")
+	return lw
+}
+
+// TestMatrixUserProfileDataSource uses the Terraform plan at:
+// => '../../examples/data_source_lacework_user_profile'
+//
+// It verifies the URL format of every region and the account type
+func TestMatrixUserProfileDataSource(t *testing.T) {
+	runMatrix(t, func(t *testing.T, tgt target) {
+		terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+			TerraformDir: "../../examples/data_source_lacework_user_profile",
+			EnvVars:      tgt.EnvVars(),
+		})
+		defer terraform.Destroy(t, terraformOptions)
+
+		terraform.InitAndApplyAndIdempotent(t, terraformOptions)
+
+		url := terraform.Output(t, terraformOptions, "lacework_user_profile_url")
+		assert.Contains(t, url, tgt.Domain())
+
+		orgAccount, err := strconv.ParseBool(
+			terraform.Output(t, terraformOptions, "lacework_user_profile_org_account"))
+		if assert.NoError(t, err) {
+			assert.Equal(t, tgt.Org, orgAccount)
+		}
+	})
+}
+
+// TestMatrixApiTokenDataSource uses the Terraform plan at:
+// => '../../examples/data_source_lacework_api_token'
+func TestMatrixApiTokenDataSource(t *testing.T) {
+	runMatrix(t, func(t *testing.T, tgt target) {
+		terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+			TerraformDir: "../../examples/data_source_lacework_api_token",
+			EnvVars:      tgt.EnvVars(),
+		})
+		defer terraform.Destroy(t, terraformOptions)
+
+		terraform.InitAndApplyAndIdempotent(t, terraformOptions)
+		assert.NotEmpty(t, terraform.Output(t, terraformOptions, "lacework_api_token"))
+	})
+}
+
+// TestMatrixAlertChannelEmail applies integration terraform:
+// => '../../examples/resource_lacework_alert_channel_email'
+//
+// It uses the go-sdk to verify the created alert channel on every target,
+// applies an update with new alert channel name and destroys it
+func TestMatrixAlertChannelEmail(t *testing.T) {
+	runMatrix(t, func(t *testing.T, tgt target) {
+		var (
+			lw               = lwTargetClient(t, tgt)
+			terraformOptions = terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+				TerraformDir: "../../examples/resource_lacework_alert_channel_email",
+				EnvVars:      tgt.EnvVars(),
+				Vars: map[string]interface{}{
+					"channel_name": "Email Alert Channel Matrix " + tgt.Name(),
+				},
+			})
+		)
+		defer terraform.Destroy(t, terraformOptions)
+
+		terraform.InitAndApply(t, terraformOptions)
+		assertAlertChannelName(t, lw, terraform.Output(t, terraformOptions, "intg_guid"),
+			"Email Alert Channel Matrix "+tgt.Name())
+
+		terraformOptions.Vars["channel_name"] = "Email Alert Channel Matrix Updated " + tgt.Name()
+		terraform.Apply(t, terraformOptions)
+		assertAlertChannelName(t, lw, terraform.Output(t, terraformOptions, "intg_guid"),
+			"Email Alert Channel Matrix Updated "+tgt.Name())
+	})
+}
+
+func assertAlertChannelName(t *testing.T, lw *api.Client, id, expected string) {
+	var res api.AlertChannelResponse
+	err := lw.V2.AlertChannels.Get(id, &res)
+	if assert.NoError(t, err, "unable to find alert channel id: %s", id) {
+		assert.Equal(t, expected, res.Data.Name)
+	}
+}
@@ -0,0 +1,148 @@
+//go:build acceptance_matrix
+
+package matrix
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/lacework/go-sdk/lwdomain"
+)
+
+// The acceptance test matrix runs a subset of the integration tests against
+// every Lacework tenant configured through environment variables. Every target
+// is identified by a region and an account type, for example, the target
+// 'eu-org' reads its credentials from the following environment variables:
+//
+//	LW_MATRIX_EU_ORG_ACCOUNT
+//	LW_MATRIX_EU_ORG_API_KEY
+//	LW_MATRIX_EU_ORG_API_SECRET
+//	LW_MATRIX_EU_ORG_SUBACCOUNT (optional)
+//
+// Use the environment variable LW_MATRIX_TARGETS to select a comma separated
+// list of targets to run, by default, all targets with credentials are selected.
+const targetsEnvVar = "LW_MATRIX_TARGETS"
+
+var (
+	regions      = []string{"us", "eu", "fra"}
+	accountTypes = []string{"standalone", "org"}
+)
+
+type target struct {
+	Region     string
+	Org        bool
+	Account    string
+	Subaccount string
+	ApiKey     string
+	ApiSecret  string
+}
+
+func (t target) Name() string {
+	if t.Org {
+		return fmt.Sprintf("%s-org", t.Region)
+	}
+	return fmt.Sprintf("%s-standalone", t.Region)
+}
+
+// Domain returns the fully qualified domain of the target account,
+// i.e. ACCOUNT[.CLUSTER].lacework.net
+func (t target) Domain() string {
+	account := t.Account
+	if d, err := lwdomain.New(account); err == nil {
+		account = d.String()
+	}
+	return fmt.Sprintf("%s.lacework.net", account)
+}
+
+// EnvVars returns the environment variables to configure the Lacework
+// provider to run against this target
+func (t target) EnvVars() map[string]string {
+	envs := map[string]string{
+		"LW_ACCOUNT":    t.Account,
+		"LW_SUBACCOUNT": t.Subaccount,
+		"LW_API_KEY":    t.ApiKey,
+		"LW_API_SECRET": t.ApiSecret,
+	}
+	if t.Org {
+		envs["LW_ORGANIZATION"] = "true"
+	}
+	return envs
+}
+
+func (t target) configured() bool {
+	return t.Account != "" && t.ApiKey != "" && t.ApiSecret != ""
+}
+
+// targetEnvName returns the name of the environment variable that configures
+// the provided setting of a target, i.e. LW_MATRIX_US_ORG_ACCOUNT
+func targetEnvName(name, setting string) string {
+	return fmt.Sprintf("LW_MATRIX_%s_%s",
+		strings.ToUpper(strings.ReplaceAll(name, "-", "_")), setting)
+}
+
+func targetEnv(name, setting string) string {
+	return os.Getenv(targetEnvName(name, setting))
+}
+
+func loadTarget(region, accountType string) target {
+	t := target{Region: region, Org: accountType == "org"}
+	t.Account = targetEnv(t.Name(), "ACCOUNT")
+	t.Subaccount = targetEnv(t.Name(), "SUBACCOUNT")
+	t.ApiKey = targetEnv(t.Name(), "API_KEY")
+	t.ApiSecret = targetEnv(t.Name(), "API_SECRET")
+	return t
+}
+
+// selectedTargets returns the list of targets to run the acceptance test matrix
+// against, an error is returned if a selected target is unknown or has missing
+// credentials
+func selectedTargets() ([]target, error) {
+	var (
+		all      = make(map[string]target)
+		names    = make([]string, 0)
+		selected = make([]target, 0)
+	)
+
+	for _, region := range regions {
+		for _, accountType := range accountTypes {
+			t := loadTarget(region, accountType)
+			all[t.Name()] = t
+			names = append(names, t.Name())
+		}
+	}
+
+	selection := os.Getenv(targetsEnvVar)
+	if selection == "" {
+		for _, name := range names {
+			if all[name].configured() {
+				selected = append(selected, all[name])
+			}
+		}
+		return selected, nil
+	}
+
+	for _, name := range strings.Split(selection, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+
+		t, ok := all[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown target '%s' in %s, valid targets are: %s",
+				name, targetsEnvVar, strings.Join(names, ", "))
+		}
+		if !t.configured() {
+			return nil, fmt.Errorf("target '%s' is missing credentials, set the environment variables %s, %s and %s",
+				name,
+				targetEnvName(name, "ACCOUNT"),
+				targetEnvName(name, "API_KEY"),
+				targetEnvName(name, "API_SECRET"),
+			)
+		}
+		selected = append(selected, t)
+	}
+
+	return selected, nil
+}
@@ -15,7 +15,7 @@ import (
 func TestAlertChannelJiraServerCreate(t *testing.T) {
 	terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
 		TerraformDir: "../examples/resource_lacework_alert_channel_jira_server",
-		EnvVars:      tokenEnvVar,
+		EnvVars:      withApiFixtures(t, tokenEnvVar),
 		Vars: map[string]interface{}{
 			"channel_name":    "My Jira Server Example",
 			"jira_url":        "test-lacework.atlassian.net",
@@ -0,0 +1,38 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestAlertChannelGenericCreate applies integration terraform:
+// => '../examples/resource_lacework_alert_channel'
+//
+// It uses the go-sdk to verify the created integration,
+// applies an update with new alert channel name and destroys it
+func TestAlertChannelGenericCreate(t *testing.T) {
+	terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+		TerraformDir: "../examples/resource_lacework_alert_channel",
+		EnvVars:      withApiFixtures(t, tokenEnvVar),
+	})
+	defer terraform.Destroy(t, terraformOptions)
+
+	// Create new Generic Alert Channel
+	terraform.InitAndApplyAndIdempotent(t, terraformOptions)
+	createData, err := LwClient.V2.AlertChannels.GetEmailUser(
+		terraform.Output(t, terraformOptions, "intg_guid"))
+	if assert.NoError(t, err) {
+		assert.Equal(t, "Generic Alert Channel Example", createData.Data.Name)
+		assert.Equal(t, "EmailUser", createData.Data.Type)
+		assert.Equal(t, []string{"foo@example.com"}, createData.Data.Data.ChannelProps.Recipients)
+	}
+
+	// Update Generic Alert Channel
+	terraformOptions.Vars = map[string]interface{}{
+		"channel_name": "Generic Alert Channel Updated"}
+
+	terraform.ApplyAndIdempotent(t, terraformOptions)
+	assert.Equal(t, "Generic Alert Channel Updated", terraform.Output(t, terraformOptions, "channel_name"))
+}
@@ -0,0 +1,50 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/lacework/go-sdk/api"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCloudAccountAwsCfg applies integration terraform:
+// => '../examples/resource_lacework_cloud_account'
+//
+// It uses the go-sdk to verify the created integration,
+// applies an update with new integration name and destroys it
+func TestCloudAccountAwsCfg(t *testing.T) {
+	awsCreds, err := awsLoadDefaultCredentials()
+	if assert.Nil(t, err, "this test requires you to set AWS_ECR_IAM environment variable") {
+		terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+			TerraformDir: "../examples/resource_lacework_cloud_account",
+			Vars: map[string]interface{}{
+				"name":        "Generic AwsCfg created by terraform",
+				"role_arn":    awsCreds.RoleArn,
+				"external_id": awsCreds.ExternalID,
+			},
+		})
+		defer terraform.Destroy(t, terraformOptions)
+
+		// Create new Cloud Account
+		terraform.InitAndApplyAndIdempotent(t, terraformOptions)
+		createData, err := LwClient.V2.CloudAccounts.GetAwsCfg(
+			terraform.Output(t, terraformOptions, "intg_guid"))
+		if assert.NoError(t, err) {
+			assert.Equal(t, "Generic AwsCfg created by terraform", createData.Data.Name)
+			assert.Equal(t, api.AwsCfgCloudAccount.String(), createData.Data.Type)
+			assert.Equal(t, awsCreds.RoleArn, createData.Data.Data.Credentials.RoleArn)
+		}
+
+		// Update Cloud Account
+		terraformOptions.Vars["name"] = "Generic AwsCfg updated by terraform"
+
+		terraform.ApplyAndIdempotent(t, terraformOptions)
+		updateData, err := LwClient.V2.CloudAccounts.GetAwsCfg(
+			terraform.Output(t, terraformOptions, "intg_guid"))
+		if assert.NoError(t, err) {
+			assert.Equal(t, "Generic AwsCfg updated by terraform", updateData.Data.Name)
+		}
+		assert.Equal(t, "Generic AwsCfg updated by terraform", terraform.Output(t, terraformOptions, "name"))
+	}
+}
@@ -0,0 +1,40 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestIntegrationAwsOrgCfg applies integration terraform:
+// => '../examples/resource_lacework_integration_aws_org_cfg'
+//
+// It verifies that an AWS Config integration is created for the member
+// account, applies an update with a new name and destroys it
+func TestIntegrationAwsOrgCfg(t *testing.T) {
+	awsCreds, err := awsLoadDefaultCredentials()
+	if assert.Nil(t, err, "this test requires you to set AWS_CREDS environment variable") {
+		terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+			TerraformDir: "../examples/resource_lacework_integration_aws_org_cfg",
+			Vars: map[string]interface{}{
+				"name":        "AwsCfg org created by terraform",
+				"role_arns":   []string{awsCreds.RoleArn},
+				"external_id": awsCreds.ExternalID,
+			},
+		})
+		defer terraform.Destroy(t, terraformOptions)
+
+		// Create the member AwsCfg integrations
+		terraform.InitAndApplyAndIdempotent(t, terraformOptions)
+		createGuids := terraform.OutputMap(t, terraformOptions, "intg_guids")
+		assert.Len(t, createGuids, 1)
+
+		// Update the member AwsCfg integrations in place
+		terraformOptions.Vars["name"] = "AwsCfg org updated by terraform"
+
+		terraform.ApplyAndIdempotent(t, terraformOptions)
+		updateGuids := terraform.OutputMap(t, terraformOptions, "intg_guids")
+		assert.Equal(t, createGuids, updateGuids)
+	}
+}
@@ -70,3 +70,57 @@ func TestPolicyExceptionInvalidConstraint(t *testing.T) {
 	_, err := terraform.InitAndApplyE(t, terraformOptions)
 	assert.ErrorContains(t, err, "[400] fieldKey: invalid is not applicable to policy lacework-global-39. Valid fieldKey are [accountIds, resourceNames, resourceTags]")
 }
+
+// TestPolicyExceptionReview verifies that the review information is stored
+// in the description of the policy exception and read back into state
+func TestPolicyExceptionReview(t *testing.T) {
+	terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+		TerraformDir: "../examples/resource_lacework_policy_exception",
+		EnvVars: map[string]string{
+			"LW_API_TOKEN":                  LwApiToken,
+			"LW_REQUIRE_EXCEPTION_APPROVAL": "true",
+		},
+		Vars: map[string]interface{}{
+			"policy_id":    "lacework-global-46",
+			"description":  "Policy Exception Created via Terraform",
+			"field_key":    "accountIds",
+			"field_values": []string{"*"},
+			"approved_by":  "security@example.com",
+			"ticket_ref":   "SEC-1234",
+		},
+	})
+	defer terraform.Destroy(t, terraformOptions)
+
+	create := terraform.InitAndApplyAndIdempotent(t, terraformOptions)
+	actualPolicyID := terraform.Output(t, terraformOptions, "policy_id")
+	createProps := GetPolicyExceptionProps(create, actualPolicyID)
+
+	assert.Equal(t,
+		"Policy Exception Created via Terraform\n\nApproved-By: security@example.com\nTicket-Ref: SEC-1234",
+		createProps.Data.Description)
+	assert.Equal(t, "Policy Exception Created via Terraform", terraform.Output(t, terraformOptions, "description"))
+	assert.Equal(t, "security@example.com", terraform.Output(t, terraformOptions, "approved_by"))
+	assert.Equal(t, "SEC-1234", terraform.Output(t, terraformOptions, "ticket_ref"))
+}
+
+// TestPolicyExceptionReviewRequired verifies that the plan fails when the provider
+// requires exceptions to be approved and the review information is missing
+func TestPolicyExceptionReviewRequired(t *testing.T) {
+	terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+		TerraformDir: "../examples/resource_lacework_policy_exception",
+		EnvVars: map[string]string{
+			"LW_API_TOKEN":                  LwApiToken,
+			"LW_REQUIRE_EXCEPTION_APPROVAL": "true",
+		},
+		Vars: map[string]interface{}{
+			"policy_id":    "lacework-global-46",
+			"description":  "Policy Exception Created via Terraform",
+			"field_key":    "accountIds",
+			"field_values": []string{"*"},
+			"approved_by":  "security@example.com",
+		},
+	})
+
+	_, err := terraform.InitAndPlanE(t, terraformOptions)
+	assert.ErrorContains(t, err, "missing required arguments: ticket_ref")
+}
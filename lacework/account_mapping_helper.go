@@ -1,7 +1,15 @@
 package lacework
 
 import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/lacework/go-sdk/api"
 )
 
 type accountMappingsFile struct {
@@ -72,3 +80,182 @@ func flattenMappings(mappings map[string]interface{}) *schema.Set {
 
 	return res
 }
+
+// orgAccountMappingsCloud describes the account mapping file of the organization level
+// integrations of a cloud provider, each cloud uses its own keys to identify the default
+// Lacework account and the cloud accounts (projects, subscriptions) of every mapping
+type orgAccountMappingsCloud struct {
+	defaultAccountKey  string
+	accountsKey        string
+	accountsName       string
+	integrationSubject string
+}
+
+var (
+	gcpOrgAccountMappings = orgAccountMappingsCloud{
+		defaultAccountKey:  "defaultLaceworkAccountGcp",
+		accountsKey:        "gcp_projects",
+		accountsName:       "GCP project IDs",
+		integrationSubject: "activity of the selected GCP projects",
+	}
+	azureOrgAccountMappings = orgAccountMappingsCloud{
+		defaultAccountKey:  "defaultLaceworkAccountAzure",
+		accountsKey:        "azure_subscriptions",
+		accountsName:       "Azure subscription IDs",
+		integrationSubject: "activity of the selected Azure subscriptions",
+	}
+)
+
+// orgAccountMappingsSchema returns the schema of the 'org_account_mappings' block of the
+// organization level integrations of the provided cloud, mirroring the AWS CloudTrail one
+func orgAccountMappingsSchema(cloud orgAccountMappingsCloud) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Description: fmt.Sprintf(
+			"Mapping of %s to Lacework accounts within a Lacework organization.", cloud.accountsName),
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"default_lacework_account": {
+					Type:     schema.TypeString,
+					Required: true,
+					Description: fmt.Sprintf(
+						"The default Lacework account name where any non-mapped %s will appear", cloud.accountsName),
+				},
+				"mapping": {
+					Type:     schema.TypeSet,
+					Required: true,
+					Description: fmt.Sprintf("A map of %s to Lacework account. "+
+						"This can be specified multiple times to map multiple Lacework accounts.", cloud.accountsName),
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"lacework_account": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: fmt.Sprintf("The Lacework account name where the %s will appear.", cloud.integrationSubject),
+							},
+							cloud.accountsKey: {
+								Type:        schema.TypeSet,
+								Elem:        &schema.Schema{Type: schema.TypeString},
+								MinItems:    1,
+								Required:    true,
+								Description: fmt.Sprintf("The list of %s to map.", cloud.accountsName),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// getResourceOrgAccountMappingsFile returns the JSON account mapping file of an organization
+// level integration of the provided cloud, or nil if the resource has no account mappings
+func getResourceOrgAccountMappingsFile(d *schema.ResourceData, cloud orgAccountMappingsCloud) ([]byte, error) {
+	accMapsInt := d.Get("org_account_mappings").([]interface{})
+	if len(accMapsInt) == 0 || accMapsInt[0] == nil {
+		return nil, nil
+	}
+
+	var (
+		accountMappings = accMapsInt[0].(map[string]interface{})
+		mappings        = map[string]interface{}{}
+	)
+	mappingSet := accountMappings["mapping"].(*schema.Set)
+	for _, m := range mappingSet.List() {
+		mapping := m.(map[string]interface{})
+		mappings[mapping["lacework_account"].(string)] = map[string]interface{}{
+			cloud.accountsKey: castStringSlice(mapping[cloud.accountsKey].(*schema.Set).List()),
+		}
+	}
+
+	return json.Marshal(map[string]interface{}{
+		cloud.defaultAccountKey: accountMappings["default_lacework_account"].(string),
+		"integration_mappings":  mappings,
+	})
+}
+
+// setResourceOrgAccountMappingsFile sets the 'org_account_mappings' block of an organization
+// level integration of the provided cloud from its JSON account mapping file
+func setResourceOrgAccountMappingsFile(d *schema.ResourceData, cloud orgAccountMappingsCloud, raw []byte) error {
+	orgAccMappings := make([]map[string]interface{}, 0, 1)
+	if len(raw) == 0 {
+		return d.Set("org_account_mappings", orgAccMappings)
+	}
+
+	mappingFile := make(map[string]interface{})
+	if err := json.Unmarshal(raw, &mappingFile); err != nil {
+		return fmt.Errorf("Error decoding organization account mapping: %s", err)
+	}
+
+	var (
+		accountMappingsSchema = orgAccountMappingsSchema(cloud).Elem.(*schema.Resource)
+		mappingSchema         = accountMappingsSchema.Schema["mapping"].Elem.(*schema.Resource)
+		accountsSchema        = mappingSchema.Schema[cloud.accountsKey].Elem.(*schema.Schema)
+		mappings              = schema.NewSet(schema.HashResource(mappingSchema), []interface{}{})
+	)
+	integrationMappings, _ := mappingFile["integration_mappings"].(map[string]interface{})
+	for laceworkAccount, m := range integrationMappings {
+		mappingValue, _ := m.(map[string]interface{})
+		accounts, _ := mappingValue[cloud.accountsKey].([]interface{})
+		mappings.Add(map[string]interface{}{
+			"lacework_account": laceworkAccount,
+			cloud.accountsKey:  schema.NewSet(schema.HashSchema(accountsSchema), accounts),
+		})
+	}
+
+	orgAccMappings = append(orgAccMappings, map[string]interface{}{
+		"default_lacework_account": mappingFile[cloud.defaultAccountKey],
+		"mapping":                  mappings,
+	})
+	if err := d.Set("org_account_mappings", orgAccMappings); err != nil {
+		return fmt.Errorf("Error flattening organization account mapping: %s", err)
+	}
+	return nil
+}
+
+// validateGcpOrgAccountMappings returns a CustomizeDiff function that only allows the
+// 'org_account_mappings' block when the GCP integration is at the organization level
+func validateGcpOrgAccountMappings(levelAttribute string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+		if !diff.NewValueKnown(levelAttribute) || !diff.NewValueKnown("org_account_mappings") {
+			return nil
+		}
+
+		if len(diff.Get("org_account_mappings").([]interface{})) == 0 {
+			return nil
+		}
+
+		if !strings.EqualFold(diff.Get(levelAttribute).(string), api.GcpOrganizationIntegration.String()) {
+			return fmt.Errorf("org_account_mappings can only be set when %s is %s",
+				levelAttribute, api.GcpOrganizationIntegration.String())
+		}
+		return nil
+	}
+}
+
+// orgAccountMappingFile extends the data of the Cloud Account integrations from the Go SDK that
+// don't support account mappings, it is encoded the same way as the AWS CloudTrail one:
+//
+// "data:application/json;name=i.json;base64,[ENCODING]"
+type orgAccountMappingFile struct {
+	AccountMappingFile string `json:"accountMappingFile,omitempty"`
+}
+
+func (f *orgAccountMappingFile) EncodeAccountMappingFile(mapping []byte) {
+	encodedMappings := base64.StdEncoding.EncodeToString(mapping)
+	f.AccountMappingFile = fmt.Sprintf("data:application/json;name=i.json;base64,%s", encodedMappings)
+}
+
+func (f *orgAccountMappingFile) DecodeAccountMappingFile() ([]byte, error) {
+	if len(f.AccountMappingFile) == 0 {
+		return []byte{}, nil
+	}
+
+	b64 := strings.SplitN(f.AccountMappingFile, ",", 2)
+	if len(b64) != 2 {
+		return []byte{}, fmt.Errorf("invalid account mapping file: %s", f.AccountMappingFile)
+	}
+	return base64.StdEncoding.DecodeString(b64[1])
+}
@@ -0,0 +1,76 @@
+package lacework
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrgAccountMappingsFileRoundTrip(t *testing.T) {
+	resourceSchema := map[string]*schema.Schema{
+		"org_account_mappings": orgAccountMappingsSchema(gcpOrgAccountMappings),
+	}
+	d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{
+		"org_account_mappings": []interface{}{map[string]interface{}{
+			"default_lacework_account": "lw-default",
+			"mapping": []interface{}{map[string]interface{}{
+				"lacework_account": "lw-sub",
+				"gcp_projects":     []interface{}{"project-1", "project-2"},
+			}},
+		}},
+	})
+
+	raw, err := getResourceOrgAccountMappingsFile(d, gcpOrgAccountMappings)
+	assert.Nil(t, err)
+
+	mappingFile := make(map[string]interface{})
+	assert.Nil(t, json.Unmarshal(raw, &mappingFile))
+	assert.Equal(t, "lw-default", mappingFile["defaultLaceworkAccountGcp"])
+	assert.Equal(t, map[string]interface{}{
+		"lw-sub": map[string]interface{}{
+			"gcp_projects": []interface{}{"project-1", "project-2"},
+		},
+	}, mappingFile["integration_mappings"])
+
+	var data orgAccountMappingFile
+	data.EncodeAccountMappingFile(raw)
+	assert.Contains(t, data.AccountMappingFile, "data:application/json;name=i.json;base64,")
+
+	decoded, err := data.DecodeAccountMappingFile()
+	assert.Nil(t, err)
+	assert.Equal(t, raw, decoded)
+
+	imported := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{})
+	assert.Nil(t, setResourceOrgAccountMappingsFile(imported, gcpOrgAccountMappings, decoded))
+	assert.Equal(t, "lw-default", imported.Get("org_account_mappings.0.default_lacework_account"))
+
+	mappings := imported.Get("org_account_mappings.0.mapping").(*schema.Set).List()
+	if assert.Len(t, mappings, 1) {
+		mapping := mappings[0].(map[string]interface{})
+		assert.Equal(t, "lw-sub", mapping["lacework_account"])
+		assert.ElementsMatch(t, []interface{}{"project-1", "project-2"},
+			mapping["gcp_projects"].(*schema.Set).List())
+	}
+}
+
+func TestOrgAccountMappingsFileEmpty(t *testing.T) {
+	resourceSchema := map[string]*schema.Schema{
+		"org_account_mappings": orgAccountMappingsSchema(azureOrgAccountMappings),
+	}
+	d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{})
+
+	raw, err := getResourceOrgAccountMappingsFile(d, azureOrgAccountMappings)
+	assert.Nil(t, err)
+	assert.Empty(t, raw)
+
+	var data orgAccountMappingFile
+	decoded, err := data.DecodeAccountMappingFile()
+	assert.Nil(t, err)
+	assert.Empty(t, decoded)
+
+	data.AccountMappingFile = "not-a-data-uri"
+	_, err = data.DecodeAccountMappingFile()
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,192 @@
+package lacework
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/lacework/go-sdk/api"
+)
+
+// getCloudAccount reads the Cloud Account integration with the provided guid and decodes
+// it onto the provided response, honoring the strict_decoding provider setting
+func getCloudAccount(meta interface{}, guid string, response interface{}) error {
+	return getIntegration(meta, fmt.Sprintf("v2/CloudAccounts/%s", guid), response)
+}
+
+// getContainerRegistry reads the Container Registry integration with the provided guid and
+// decodes it onto the provided response, honoring the strict_decoding provider setting
+func getContainerRegistry(meta interface{}, guid string, response interface{}) error {
+	return getIntegration(meta, fmt.Sprintf("v2/ContainerRegistries/%s", guid), response)
+}
+
+// getAlertChannel reads the alert channel with the provided guid and decodes it onto
+// the provided response, honoring the strict_decoding provider setting
+func getAlertChannel(meta interface{}, guid string, response interface{}) error {
+	return getIntegration(meta, fmt.Sprintf("v2/AlertChannels/%s", guid), response)
+}
+
+// getEmailUserAlertChannel reads the EmailUser alert channel with the provided guid, honoring
+// the strict_decoding provider setting. Like the Lacework Go SDK, it handles the recipients
+// that the Lacework API sometimes returns as a single comma-separated string
+func getEmailUserAlertChannel(meta interface{}, guid string) (api.EmailUserAlertChannelResponse, error) {
+	var response api.EmailUserAlertChannelResponse
+
+	raw, err := getIntegrationRaw(meta, fmt.Sprintf("v2/AlertChannels/%s", guid))
+	if err != nil {
+		return response, err
+	}
+
+	raw, err = splitEmailUserRecipients(raw)
+	if err != nil {
+		return response, err
+	}
+
+	err = decodeIntegrationResponse(raw, &response, meta.(*providerMeta).strictDecoding)
+	return response, err
+}
+
+// splitEmailUserRecipients converts the recipients of a raw EmailUser response from a
+// comma-separated string to a list, see https://lacework.atlassian.net/browse/RAIN-20070
+func splitEmailUserRecipients(raw []byte) ([]byte, error) {
+	var response map[string]interface{}
+	if err := decodeJSON(raw, &response); err != nil {
+		return nil, err
+	}
+
+	data, _ := integrationResponseData(response).(map[string]interface{})
+	channelProps, _ := data["channelProps"].(map[string]interface{})
+	recipients, ok := channelProps["recipients"].(string)
+	if !ok {
+		return raw, nil
+	}
+
+	channelProps["recipients"] = strings.Split(recipients, ",")
+	return json.Marshal(response)
+}
+
+func getIntegration(meta interface{}, path string, response interface{}) error {
+	raw, err := getIntegrationRaw(meta, path)
+	if err != nil {
+		return err
+	}
+
+	return decodeIntegrationResponse(raw, response, meta.(*providerMeta).strictDecoding)
+}
+
+func getIntegrationRaw(meta interface{}, path string) ([]byte, error) {
+	var raw bytes.Buffer
+	if err := meta.(*providerMeta).client.RequestDecoder("GET", path, nil, &raw); err != nil {
+		return nil, err
+	}
+	return raw.Bytes(), nil
+}
+
+// decodeIntegrationResponse decodes the raw response of an integration onto the provided
+// response, when strict is true, it fails if the data of the integration has settings that
+// are unknown to the vendored Lacework Go SDK, since the response types of the SDK drop them
+// and they would be lost when updating the integration. Settings known to the SDK but not
+// exposed by the resource schema are not detected, the response is the only reference
+// available for every integration type
+func decodeIntegrationResponse(raw []byte, response interface{}, strict bool) error {
+	if err := decodeJSON(raw, response); err != nil {
+		return err
+	}
+
+	if !strict {
+		return nil
+	}
+
+	decoded, err := json.Marshal(response)
+	if err != nil {
+		return err
+	}
+
+	var rawResponse, decodedResponse map[string]interface{}
+	if err := decodeJSON(raw, &rawResponse); err != nil {
+		return err
+	}
+	if err := decodeJSON(decoded, &decodedResponse); err != nil {
+		return err
+	}
+
+	unknownFields := unknownSdkFields("data",
+		integrationResponseData(rawResponse), integrationResponseData(decodedResponse))
+	if len(unknownFields) != 0 {
+		sort.Strings(unknownFields)
+		return fmt.Errorf(
+			"The Lacework API returned integration settings unknown to the Lacework Go SDK "+
+				"used by this version of the provider: %s. "+
+				"Upgrade the provider to avoid losing them when the integration is updated, "+
+				"or set strict_decoding to false to ignore them.",
+			strings.Join(unknownFields, ", "),
+		)
+	}
+	return nil
+}
+
+// decodeJSON decodes numbers the same way as the Lacework Go SDK does
+func decodeJSON(raw []byte, v interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	return decoder.Decode(v)
+}
+
+// integrationResponseData returns the 'data' of the integration from a decoded response
+func integrationResponseData(response map[string]interface{}) interface{} {
+	integration, _ := response["data"].(map[string]interface{})
+	return integration["data"]
+}
+
+// unknownSdkFields returns the path of the fields that have a value in the raw response
+// but are missing once decoded onto the SDK response type, empty values are ignored
+func unknownSdkFields(path string, raw, decoded interface{}) []string {
+	unknownFields := make([]string, 0)
+	switch rawValue := raw.(type) {
+	case map[string]interface{}:
+		decodedValue, _ := decoded.(map[string]interface{})
+		for key, value := range rawValue {
+			if isEmptyResponseValue(value) {
+				continue
+			}
+			fieldPath := fmt.Sprintf("%s.%s", path, key)
+			decodedField, ok := decodedValue[key]
+			if !ok {
+				unknownFields = append(unknownFields, fieldPath)
+				continue
+			}
+			unknownFields = append(unknownFields, unknownSdkFields(fieldPath, value, decodedField)...)
+		}
+	case []interface{}:
+		decodedValue, _ := decoded.([]interface{})
+		for i, value := range rawValue {
+			if i >= len(decodedValue) {
+				break
+			}
+			unknownFields = append(unknownFields,
+				unknownSdkFields(fmt.Sprintf("%s.%d", path, i), value, decodedValue[i])...)
+		}
+	}
+	return unknownFields
+}
+
+func isEmptyResponseValue(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case bool:
+		return !v
+	case json.Number:
+		f, err := v.Float64()
+		return err == nil && f == 0
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return false
+}
@@ -0,0 +1,106 @@
+package lacework
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lacework/go-sdk/api"
+)
+
+func TestDecodeIntegrationResponse(t *testing.T) {
+	raw := []byte(`{
+  "data": {
+    "intgGuid": "TECHALLY_000000000000AAAAAAAAAAAAAAAAAAAA",
+    "name": "integration_name",
+    "type": "AwsCfg",
+    "enabled": 1,
+    "props": {"owner": "someone"},
+    "data": {
+      "crossAccountCredentials": {
+        "roleArn": "arn:aws:iam::123456789012:role/lacework_iam_example_role",
+        "externalId": "abc123"
+      },
+      "awsAccountId": "123456789012",
+      "regions": [],
+      "newerSetting": ""
+    }
+  }
+}`)
+
+	var response api.AwsCfgIntegrationResponse
+	if assert.Nil(t, decodeIntegrationResponse(raw, &response, true)) {
+		assert.Equal(t, "abc123", response.Data.Data.Credentials.ExternalID)
+	}
+}
+
+func TestDecodeIntegrationResponseUnknownFields(t *testing.T) {
+	raw := []byte(`{
+  "data": {
+    "intgGuid": "TECHALLY_000000000000AAAAAAAAAAAAAAAAAAAA",
+    "name": "integration_name",
+    "type": "AwsCfg",
+    "enabled": 1,
+    "data": {
+      "crossAccountCredentials": {
+        "roleArn": "arn:aws:iam::123456789012:role/lacework_iam_example_role",
+        "externalId": "abc123",
+        "sessionDuration": 3600
+      },
+      "newerSetting": "value"
+    }
+  }
+}`)
+
+	var response api.AwsCfgIntegrationResponse
+	assert.Nil(t, decodeIntegrationResponse(raw, &response, false))
+	assert.Equal(t, "abc123", response.Data.Data.Credentials.ExternalID)
+
+	err := decodeIntegrationResponse(raw, &response, true)
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "data.crossAccountCredentials.sessionDuration, data.newerSetting")
+	}
+}
+
+func TestDecodeIntegrationResponseRawData(t *testing.T) {
+	raw := []byte(`{
+  "data": {
+    "intgGuid": "TECHALLY_000000000000AAAAAAAAAAAAAAAAAAAA",
+    "name": "integration_name",
+    "type": "NewType",
+    "enabled": 1,
+    "data": {"anySetting": "value", "nested": {"limit": 10}}
+  }
+}`)
+
+	var response api.CloudAccountResponse
+	assert.Nil(t, decodeIntegrationResponse(raw, &response, true))
+}
+
+func TestSplitEmailUserRecipients(t *testing.T) {
+	for name, recipients := range map[string]string{
+		"list":   `["foo@example.com", "bar@example.com"]`,
+		"string": `"foo@example.com,bar@example.com"`,
+	} {
+		raw := []byte(`{
+  "data": {
+    "intgGuid": "TECHALLY_000000000000AAAAAAAAAAAAAAAAAAAA",
+    "name": "email",
+    "type": "EmailUser",
+    "enabled": 1,
+    "data": {"channelProps": {"recipients": ` + recipients + `}}
+  }
+}`)
+
+		raw, err := splitEmailUserRecipients(raw)
+		if !assert.Nil(t, err, name) {
+			continue
+		}
+
+		var response api.EmailUserAlertChannelResponse
+		if assert.Nil(t, decodeIntegrationResponse(raw, &response, true), name) {
+			assert.Equal(t, []string{"foo@example.com", "bar@example.com"},
+				response.Data.Data.ChannelProps.Recipients, name)
+		}
+	}
+}
@@ -0,0 +1,401 @@
+package lacework
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+)
+
+// hidden environment variables, not exposed as provider arguments, used to run
+// acceptance tests without a live Lacework account
+const (
+	// the base URL of a local mock server of the Lacework API, TLS verification
+	// and the token exchange are disabled when it is set
+	apiMockURLEnv = "LW_API_MOCK_URL"
+
+	// the path of a file with recorded API fixtures, the fixtures are replayed
+	// instead of sending the requests, or recorded when apiFixturesModeEnv is 'record',
+	// new fixtures are appended to the file
+	apiFixturesEnv     = "LW_API_FIXTURES"
+	apiFixturesModeEnv = "LW_API_FIXTURES_MODE"
+
+	apiFixturesModeRecord = "record"
+
+	// credentials used when no credentials are configured in mock mode
+	apiMockAccount = "mock"
+	apiMockToken   = "_mock_token"
+
+	// the token exchange is never recorded since its response is an access token
+	apiFixturesTokenPath = "/api/v2/access/tokens"
+)
+
+type apiMockConfig struct {
+	url      string
+	fixtures string
+	record   bool
+}
+
+func apiMockConfigFromEnv() apiMockConfig {
+	return apiMockConfig{
+		url:      os.Getenv(apiMockURLEnv),
+		fixtures: os.Getenv(apiFixturesEnv),
+		record:   strings.EqualFold(os.Getenv(apiFixturesModeEnv), apiFixturesModeRecord),
+	}
+}
+
+// offline returns true if the requests are not sent to the Lacework platform, either
+// because they are sent to a mock server or because recorded fixtures are replayed
+func (c apiMockConfig) offline() bool {
+	return c.url != "" || (c.fixtures != "" && !c.record)
+}
+
+// apiFixture is a recorded API request and its response
+type apiFixture struct {
+	Method     string `json:"method"`
+	URL        string `json:"url"`
+	Request    string `json:"request,omitempty"`
+	StatusCode int    `json:"status_code"`
+	Response   string `json:"response,omitempty"`
+}
+
+// apiFixtureTransport replays the API responses recorded in a file of fixtures, or records
+// them when there is a next transport. Requests that modify resources are matched by method
+// and URL in the order they were recorded, each fixture is replayed once. GET requests are not
+// consumed, they replay the state recorded after the last replayed request that modified a
+// resource, since the number of refreshes varies between Terraform versions.
+//
+// Terraform starts a new provider process for every command and the tests send their own
+// requests, so the replay state is stored in a file next to the fixtures and the recorded
+// fixtures are appended to the existing file
+type apiFixtureTransport struct {
+	next http.RoundTripper
+	path string
+
+	mu       sync.Mutex
+	fixtures []apiFixture
+	state    apiFixtureState
+}
+
+// apiFixtureState is the index of the last replayed request that modified a resource,
+// the indexes of all of them, and the secrets they sent keyed by field name
+type apiFixtureState struct {
+	Cursor  int                    `json:"cursor"`
+	Used    []int                  `json:"used"`
+	Secrets map[string]interface{} `json:"secrets,omitempty"`
+}
+
+func newApiFixtureTransport(path string, next http.RoundTripper) *apiFixtureTransport {
+	return &apiFixtureTransport{path: path, next: next, state: apiFixtureState{Cursor: -1}}
+}
+
+// ApiFixtureTransport returns a transport that replays the API fixtures of the provided file,
+// or records them against the Lacework API, the integration tests use it to send their own
+// requests through the same fixtures as the provider
+func ApiFixtureTransport(path string, record bool) http.RoundTripper {
+	if record {
+		return newApiFixtureTransport(path, http.DefaultTransport)
+	}
+	return newApiFixtureTransport(path, nil)
+}
+
+// apiFixtureStatePath returns the path of the file with the replay state of the fixtures
+func apiFixtureStatePath(path string) string {
+	return path + ".state"
+}
+
+func (t *apiFixtureTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.next != nil {
+		return t.record(req)
+	}
+
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	fixture, err := t.replay(req, body)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("[DEBUG] Lacework API fixture replayed: %s %s\n", req.Method, req.URL.RequestURI())
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", fixture.StatusCode, http.StatusText(fixture.StatusCode)),
+		StatusCode:    fixture.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(strings.NewReader(fixture.Response)),
+		ContentLength: int64(len(fixture.Response)),
+		Request:       req,
+	}, nil
+}
+
+// replay returns the fixture of the request, the secrets of its body are kept in the replay
+// state so that the redacted secrets of the responses are replayed as they were sent
+func (t *apiFixtureTransport) replay(req *http.Request, body []byte) (apiFixture, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if err := t.load(); err != nil {
+		return apiFixture{}, err
+	}
+
+	i, ok := t.match(req)
+	if !ok {
+		return apiFixture{}, fmt.Errorf("no recorded API fixture for %s %s in %s",
+			req.Method, req.URL.RequestURI(), t.path)
+	}
+
+	if req.Method != http.MethodGet {
+		t.state.Cursor = i
+		t.state.Used = append(t.state.Used, i)
+		if t.state.Secrets == nil {
+			t.state.Secrets = make(map[string]interface{})
+		}
+		collectSecrets(body, t.state.Secrets)
+		if err := t.saveState(); err != nil {
+			return apiFixture{}, err
+		}
+	}
+
+	fixture := t.fixtures[i]
+	fixture.Response = restoreSecrets(fixture.Response, t.state.Secrets)
+	return fixture, nil
+}
+
+// load reads the fixtures once, and the replay state every time since it is shared
+// with the other processes replaying the same fixtures
+func (t *apiFixtureTransport) load() error {
+	if t.fixtures == nil {
+		raw, err := os.ReadFile(t.path)
+		if err != nil {
+			return fmt.Errorf("unable to load API fixtures: %s", err)
+		}
+		if err := json.Unmarshal(raw, &t.fixtures); err != nil {
+			return fmt.Errorf("unable to decode API fixtures from %s: %s", t.path, err)
+		}
+	}
+
+	if t.path == "" {
+		return nil
+	}
+	raw, err := os.ReadFile(apiFixtureStatePath(t.path))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("unable to load the replay state of API fixtures: %s", err)
+	}
+	if err := json.Unmarshal(raw, &t.state); err != nil {
+		return fmt.Errorf("unable to decode the replay state of API fixtures: %s", err)
+	}
+	return nil
+}
+
+func (t *apiFixtureTransport) saveState() error {
+	if t.path == "" {
+		return nil
+	}
+	raw, err := json.Marshal(t.state)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(apiFixtureStatePath(t.path), raw, 0600); err != nil {
+		return fmt.Errorf("unable to store the replay state of API fixtures: %s", err)
+	}
+	return nil
+}
+
+// match returns the index of the fixture to replay for the request, GET requests replay the
+// first fixture recorded after the cursor and before the next request that modified a resource,
+// or the last one recorded before the cursor, other requests replay the first unused fixture
+func (t *apiFixtureTransport) match(req *http.Request) (int, bool) {
+	matches := func(fixture apiFixture) bool {
+		return fixture.Method == req.Method && fixture.URL == req.URL.RequestURI()
+	}
+
+	if req.Method != http.MethodGet {
+		for i, fixture := range t.fixtures {
+			if matches(fixture) && !t.used(i) {
+				return i, true
+			}
+		}
+		return -1, false
+	}
+
+	for i := t.state.Cursor + 1; i < len(t.fixtures) && t.fixtures[i].Method == http.MethodGet; i++ {
+		if matches(t.fixtures[i]) {
+			return i, true
+		}
+	}
+	for i := t.state.Cursor; i >= 0; i-- {
+		if matches(t.fixtures[i]) {
+			return i, true
+		}
+	}
+	for i := t.state.Cursor + 1; i < len(t.fixtures); i++ {
+		if matches(t.fixtures[i]) {
+			return i, true
+		}
+	}
+	return -1, false
+}
+
+func (t *apiFixtureTransport) used(i int) bool {
+	for _, used := range t.state.Used {
+		if used == i {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *apiFixtureTransport) record(req *http.Request) (*http.Response, error) {
+	fixture := apiFixture{Method: req.Method, URL: req.URL.RequestURI()}
+
+	if req.Body != nil && req.Body != http.NoBody {
+		body, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+
+		// request bodies are only recorded for troubleshooting, they are not used to
+		// match requests
+		fixture.Request = redactFixtureBody(body)
+	}
+
+	res, err := t.next.RoundTrip(req)
+	if err != nil || req.URL.Path == apiFixturesTokenPath {
+		return res, err
+	}
+
+	body, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(body))
+
+	// the secrets of responses are replayed from the requests that sent them
+	fixture.StatusCode = res.StatusCode
+	fixture.Response = redactFixtureBody(body)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	// other processes may have recorded fixtures in the same file since the last request
+	var fixtures []apiFixture
+	if raw, err := os.ReadFile(t.path); err == nil {
+		if err := json.Unmarshal(raw, &fixtures); err != nil {
+			return nil, fmt.Errorf("unable to decode API fixtures from %s: %s", t.path, err)
+		}
+	}
+
+	fixtures = append(fixtures, fixture)
+	raw, err := json.MarshalIndent(fixtures, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(t.path, raw, 0600); err != nil {
+		return nil, fmt.Errorf("unable to record API fixtures: %s", err)
+	}
+
+	log.Printf("[DEBUG] Lacework API fixture recorded: %s %s\n", req.Method, req.URL.RequestURI())
+	return res, nil
+}
+
+// redactFixtureBody returns the provided JSON body with the values of all sensitive fields
+// redacted, unlike the logs the body is never truncated, bodies that are not JSON are kept
+func redactFixtureBody(body []byte) string {
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return string(body)
+	}
+
+	redacted, err := json.Marshal(redactValue(v))
+	if err != nil {
+		return string(body)
+	}
+	return string(redacted)
+}
+
+// collectSecrets adds the values of the sensitive fields of the provided JSON body to the
+// secrets, keyed by field name
+func collectSecrets(body []byte, secrets map[string]interface{}) {
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return
+	}
+	walkSensitiveFields(v, func(field string, value interface{}) interface{} {
+		if value != nil && value != "" && value != apiLogRedacted {
+			secrets[field] = value
+		}
+		return value
+	})
+}
+
+// restoreSecrets returns the provided JSON body with its redacted fields replaced by the
+// secrets of the same name, the fields without a secret stay redacted
+func restoreSecrets(body string, secrets map[string]interface{}) string {
+	if len(secrets) == 0 || !strings.Contains(body, apiLogRedacted) {
+		return body
+	}
+
+	var v interface{}
+	if err := json.Unmarshal([]byte(body), &v); err != nil {
+		return body
+	}
+	walkSensitiveFields(v, func(field string, value interface{}) interface{} {
+		if secret, ok := secrets[field]; ok && value == apiLogRedacted {
+			return secret
+		}
+		return value
+	})
+
+	restored, err := json.Marshal(v)
+	if err != nil {
+		return body
+	}
+	return string(restored)
+}
+
+// walkSensitiveFields replaces the values of all sensitive fields of the provided value
+// with the result of the update function
+func walkSensitiveFields(v interface{}, update func(field string, value interface{}) interface{}) {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		for k, field := range value {
+			if sensitiveApiLogField(k) {
+				value[k] = update(k, field)
+				continue
+			}
+			walkSensitiveFields(field, update)
+		}
+	case []interface{}:
+		for _, item := range value {
+			walkSensitiveFields(item, update)
+		}
+	}
+}
+
+// insecureTLSConfig disables the verification of the certificates of the mock server
+func insecureTLSConfig() *tls.Config {
+	return &tls.Config{InsecureSkipVerify: true} // #nosec G402 -- only used with a local mock server
+}
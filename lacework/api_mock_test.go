@@ -0,0 +1,256 @@
+package lacework
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lacework/go-sdk/api"
+)
+
+const mockSlackChannelResponse = `{
+  "data": {
+    "intgGuid": "MOCK_000000000000AAAAAAAAAAAAAAAAAAAA",
+    "name": "slack",
+    "type": "SlackChannel",
+    "enabled": 1,
+    "data": {"slackUrl": "https://hooks.slack.com/services/ABC"}
+  }
+}`
+
+func TestProviderMockServerMode(t *testing.T) {
+	var tokenRequests int32
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == apiFixturesTokenPath {
+			atomic.AddInt32(&tokenRequests, 1)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		assert.Equal(t, apiMockToken, r.Header.Get("Authorization"))
+		assert.Equal(t, "/api/v2/AlertChannels/MOCK_000000000000AAAAAAAAAAAAAAAAAAAA", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(mockSlackChannelResponse))
+	}))
+	defer server.Close()
+
+	t.Setenv(apiMockURLEnv, server.URL)
+
+	meta := configureMockProvider(t)
+	var response api.SlackChannelAlertChannelResponseV2
+	if assert.Nil(t, getAlertChannel(meta, "MOCK_000000000000AAAAAAAAAAAAAAAAAAAA", &response)) {
+		assert.Equal(t, "https://hooks.slack.com/services/ABC", response.Data.Data.SlackUrl)
+	}
+	assert.Equal(t, int32(0), atomic.LoadInt32(&tokenRequests), "the token must not be exchanged")
+}
+
+func TestProviderMockServerModeIgnoresApiKeys(t *testing.T) {
+	var tokenRequests int32
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == apiFixturesTokenPath {
+			atomic.AddInt32(&tokenRequests, 1)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		assert.Equal(t, apiMockToken, r.Header.Get("Authorization"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(mockSlackChannelResponse))
+	}))
+	defer server.Close()
+
+	t.Setenv(apiMockURLEnv, server.URL)
+
+	meta := configureMockProviderRaw(t, map[string]interface{}{
+		"api_cache_ttl": 0,
+		"api_key":       "MOCK_KEY",
+		"api_secret":    "_mock_secret",
+	})
+	var response api.SlackChannelAlertChannelResponseV2
+	assert.Nil(t, getAlertChannel(meta, "MOCK_000000000000AAAAAAAAAAAAAAAAAAAA", &response))
+
+	// the client is keyless, so even an explicit token request is never sent
+	_, err := meta.(*providerMeta).client.GenerateToken()
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "auth keys missing")
+	}
+	assert.Equal(t, int32(0), atomic.LoadInt32(&tokenRequests), "the API keys must not be exchanged")
+}
+
+func TestProviderFixturesMode(t *testing.T) {
+	fixtures := filepath.Join(t.TempDir(), "fixtures.json")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(mockSlackChannelResponse))
+	}))
+
+	// record the fixtures against the mock server
+	t.Setenv(apiMockURLEnv, server.URL)
+	t.Setenv(apiFixturesEnv, fixtures)
+	t.Setenv(apiFixturesModeEnv, "record")
+
+	meta := configureMockProvider(t)
+	var recorded api.SlackChannelAlertChannelResponseV2
+	assert.Nil(t, getAlertChannel(meta, "MOCK_000000000000AAAAAAAAAAAAAAAAAAAA", &recorded))
+	server.Close()
+
+	// replay them without any server
+	t.Setenv(apiMockURLEnv, "")
+	t.Setenv(apiFixturesModeEnv, "")
+
+	meta = configureMockProvider(t)
+	var replayed api.SlackChannelAlertChannelResponseV2
+	if assert.Nil(t, getAlertChannel(meta, "MOCK_000000000000AAAAAAAAAAAAAAAAAAAA", &replayed)) {
+		assert.Equal(t, "https://hooks.slack.com/services/ABC", recorded.Data.Data.SlackUrl)
+		assert.Equal(t, apiLogRedacted, replayed.Data.Data.SlackUrl,
+			"secrets that were not sent by a replayed request stay redacted")
+		replayed.Data.Data.SlackUrl = recorded.Data.Data.SlackUrl
+		assert.Equal(t, recorded, replayed)
+	}
+
+	var missing api.SlackChannelAlertChannelResponseV2
+	err := getAlertChannel(meta, "MOCK_MISSING", &missing)
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "no recorded API fixture for GET /api/v2/AlertChannels/MOCK_MISSING")
+	}
+}
+
+func TestApiFixtureTransportReplay(t *testing.T) {
+	transport := newApiFixtureTransport("", nil)
+	transport.fixtures = []apiFixture{
+		{Method: "GET", URL: "/api/v2/Policies/a", StatusCode: 200, Response: `{"v":1}`},
+		{Method: "PATCH", URL: "/api/v2/Policies/a", StatusCode: 200, Response: `{"v":2}`},
+		{Method: "GET", URL: "/api/v2/Policies/a", StatusCode: 200, Response: `{"v":2}`},
+		{Method: "GET", URL: "/api/v2/Policies/a", StatusCode: 200, Response: `{"v":2}`},
+	}
+
+	// fixtures of requests that modify resources are replayed in order, and GET
+	// requests replay the state after the last of them, however often they are sent
+	assert.Equal(t, `{"v":1}`, sendFixtureRequest(t, transport, "GET"))
+	assert.Equal(t, `{"v":1}`, sendFixtureRequest(t, transport, "GET"))
+	assert.Equal(t, `{"v":2}`, sendFixtureRequest(t, transport, "PATCH"))
+	assert.Equal(t, `{"v":2}`, sendFixtureRequest(t, transport, "GET"))
+	assert.Equal(t, `{"v":2}`, sendFixtureRequest(t, transport, "GET"))
+	assert.Equal(t, `{"v":2}`, sendFixtureRequest(t, transport, "GET"))
+
+	req, _ := http.NewRequest("PATCH", "https://mock.lacework.net/api/v2/Policies/a", strings.NewReader(`{}`))
+	_, err := transport.RoundTrip(req)
+	assert.Error(t, err, "requests that modify resources must not be reused")
+}
+
+func TestApiFixtureTransportRecordRedactsSecrets(t *testing.T) {
+	fixtures := filepath.Join(t.TempDir(), "fixtures.json")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// the response echoes the secrets of the request, like the Lacework API
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(fmt.Sprintf(`{"data":%s}`, body)))
+	}))
+	defer server.Close()
+
+	request := `{"name":"slack","data":{"slackUrl":"https://hooks.slack.com/services/SECRET",` +
+		`"credentials":{"privateKey":"SECRET_KEY","clientEmail":"lacework@example.com"}}}`
+	req, _ := http.NewRequest("POST", server.URL+"/api/v2/AlertChannels", strings.NewReader(request))
+	res, err := newApiFixtureTransport(fixtures, http.DefaultTransport).RoundTrip(req)
+	if assert.NoError(t, err) {
+		body, _ := io.ReadAll(res.Body)
+		assert.Contains(t, string(body), "SECRET", "the response must not be modified when recording")
+	}
+
+	raw, err := os.ReadFile(fixtures)
+	if assert.NoError(t, err) {
+		assert.NotContains(t, string(raw), "SECRET")
+		assert.Contains(t, string(raw), "slack")
+	}
+
+	// the replayed response has the secrets of the replayed request
+	req, _ = http.NewRequest("POST", "https://mock.lacework.net/api/v2/AlertChannels", strings.NewReader(request))
+	res, err = newApiFixtureTransport(fixtures, nil).RoundTrip(req)
+	if assert.NoError(t, err) {
+		body, _ := io.ReadAll(res.Body)
+		assert.JSONEq(t, fmt.Sprintf(`{"data":%s}`, request), string(body))
+	}
+}
+
+func TestApiFixtureTransportProcesses(t *testing.T) {
+	var (
+		fixtures = filepath.Join(t.TempDir(), "fixtures.json")
+		version  int32
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			atomic.AddInt32(&version, 1)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(fmt.Sprintf(`{"v":%d}`, atomic.LoadInt32(&version))))
+	}))
+	defer server.Close()
+
+	// every process records its requests in the same file
+	sendFixtureRequestTo(t, newApiFixtureTransport(fixtures, http.DefaultTransport), "GET", server.URL)
+	sendFixtureRequestTo(t, newApiFixtureTransport(fixtures, http.DefaultTransport), "PATCH", server.URL)
+	sendFixtureRequestTo(t, newApiFixtureTransport(fixtures, http.DefaultTransport), "GET", server.URL)
+
+	// and every process replays them from the state left by the previous one
+	assert.Equal(t, `{"v":0}`, sendFixtureRequest(t, newApiFixtureTransport(fixtures, nil), "GET"))
+	assert.Equal(t, `{"v":1}`, sendFixtureRequest(t, newApiFixtureTransport(fixtures, nil), "PATCH"))
+	assert.Equal(t, `{"v":1}`, sendFixtureRequest(t, newApiFixtureTransport(fixtures, nil), "GET"))
+	assert.FileExists(t, apiFixtureStatePath(fixtures))
+}
+
+func TestIntegrationApiFixtures(t *testing.T) {
+	paths, err := filepath.Glob(filepath.Join("..", "integration", "fixtures", "*.json"))
+	if !assert.NoError(t, err) || !assert.NotEmpty(t, paths) {
+		return
+	}
+
+	for _, path := range paths {
+		transport := newApiFixtureTransport(path, nil)
+		if !assert.NoError(t, transport.load(), path) {
+			continue
+		}
+		for _, fixture := range transport.fixtures {
+			assert.NotEqual(t, apiFixturesTokenPath, fixture.URL, path)
+			assert.NotContains(t, fixture.Response, apiMockToken, path)
+			if fixture.Response != "" {
+				assert.Equal(t, redactFixtureBody([]byte(fixture.Response)), fixture.Response,
+					"the secrets of %s %s in %s must be redacted", fixture.Method, fixture.URL, path)
+			}
+		}
+	}
+}
+
+func sendFixtureRequest(t *testing.T, transport http.RoundTripper, method string) string {
+	return sendFixtureRequestTo(t, transport, method, "https://mock.lacework.net")
+}
+
+func sendFixtureRequestTo(t *testing.T, transport http.RoundTripper, method, url string) string {
+	req, _ := http.NewRequest(method, url+"/api/v2/Policies/a", nil)
+	res, err := transport.RoundTrip(req)
+	if !assert.NoError(t, err) {
+		return ""
+	}
+	body, _ := io.ReadAll(res.Body)
+	return string(body)
+}
+
+func configureMockProvider(t *testing.T) interface{} {
+	return configureMockProviderRaw(t, map[string]interface{}{"api_cache_ttl": 0})
+}
+
+func configureMockProviderRaw(t *testing.T, raw map[string]interface{}) interface{} {
+	d := schema.TestResourceDataRaw(t, Provider().Schema, raw)
+	meta, diags := providerConfigure(context.Background(), d)
+	if !assert.False(t, diags.HasError(), diags) {
+		t.FailNow()
+	}
+	return meta
+}
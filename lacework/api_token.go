@@ -0,0 +1,63 @@
+package lacework
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/lacework/go-sdk/api"
+)
+
+// apiTokenOptions returns the options to authenticate the API client with a pre-issued
+// access token, when the provider is configured without API keys (keyless) the token
+// can't be exchanged for a new one, so the client uses it until it expires
+func apiTokenOptions(token string, keyless bool) []api.Option {
+	if token == "" {
+		return nil
+	}
+
+	if !keyless {
+		return []api.Option{api.WithToken(token)}
+	}
+
+	log.Println("[INFO] Using a pre-issued Lacework API access token without API keys")
+	opts := []api.Option{
+		api.WithLifecycleCallbacks(api.LifecycleCallbacks{
+			TokenExpiredCallback: func() error {
+				log.Println("[WARN] The Lacework API access token expired and there are no API keys to generate a new one")
+				log.Println("[WARN] Issue a new token and configure it with the api_token argument or the LW_API_TOKEN environment variable")
+				return nil
+			},
+		}),
+	}
+
+	if expiresAt, ok := apiTokenExpiration(token); ok {
+		log.Printf("[INFO] Lacework API access token expires at %s\n", expiresAt.Format(time.RFC3339))
+		return append(opts, api.WithTokenAndExpiration(token, expiresAt))
+	}
+	return append(opts, api.WithToken(token))
+}
+
+// apiTokenExpiration returns the expiration time of tokens issued as JWTs, like the
+// temporary tokens issued by an identity provider, from their 'exp' claim
+func apiTokenExpiration(token string) (time.Time, bool) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}, false
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	var claims struct {
+		Exp int64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Exp == 0 {
+		return time.Time{}, false
+	}
+	return time.Unix(claims.Exp, 0).UTC(), true
+}
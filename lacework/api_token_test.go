@@ -0,0 +1,33 @@
+package lacework
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApiTokenExpiration(t *testing.T) {
+	payload := base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"ci","exp":1767225600}`))
+	expiresAt, ok := apiTokenExpiration("eyJhbGciOiJIUzI1NiJ9." + payload + ".signature")
+	if assert.True(t, ok) {
+		assert.Equal(t, time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC), expiresAt)
+	}
+
+	noExp := base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"ci"}`))
+	_, ok = apiTokenExpiration("eyJhbGciOiJIUzI1NiJ9." + noExp + ".signature")
+	assert.False(t, ok, "tokens without an exp claim should use the default expiration")
+
+	_, ok = apiTokenExpiration("_1234abcdef567890abcdef1234567890")
+	assert.False(t, ok, "opaque tokens should use the default expiration")
+
+	_, ok = apiTokenExpiration("a.not-base64!.c")
+	assert.False(t, ok)
+}
+
+func TestApiTokenOptions(t *testing.T) {
+	assert.Empty(t, apiTokenOptions("", true))
+	assert.Len(t, apiTokenOptions("_1234abcdef", false), 1)
+	assert.Len(t, apiTokenOptions("_1234abcdef", true), 2, "keyless tokens should configure the expired token callback")
+}
@@ -0,0 +1,396 @@
+package lacework
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	apiLogLevelError = "ERROR"
+	apiLogLevelInfo  = "INFO"
+	apiLogLevelDebug = "DEBUG"
+
+	// the maximum size of a request or response body written to the logs
+	apiLogMaxBodySize = 32 * 1024
+
+	apiLogRedacted = "REDACTED"
+
+	// the default number of seconds that responses of GET requests are cached, the
+	// cache is opt-in so that resource reads and refreshes always hit the API
+	apiCacheDefaultTTL = 0
+)
+
+var apiLogLevels = []string{apiLogLevelError, apiLogLevelInfo, apiLogLevelDebug}
+
+// sensitive headers and body fields (lowercase, without underscores) that are
+// redacted from the API traffic logs
+var (
+	apiLogSensitiveHeaders = []string{"authorization", "x-lw-uaks", "cookie", "set-cookie"}
+	apiLogSensitiveFields  = []string{
+		"secret", "password", "passwd", "passphrase", "token", "privatekey", "apikey",
+		"accesskey", "integrationkey", "routingkey", "intgkey", "insertkey", "credential", "webhook",
+		"slackurl", "teamsurl", "intgurl",
+	}
+	apiLogNonSensitiveFields = []string{"tokenalias", "tokenenabled", "tokentype"}
+)
+
+// newApiTransport returns the HTTP transport used by the Lacework API client,
+// the provided log level configures the logging of the API traffic, the
+// cache TTL the time that responses of GET requests are cached (zero disables it)
+// and the mock config whether the traffic goes to a mock server or to fixtures
+func newApiTransport(logLevel string, cacheTTL time.Duration, mock apiMockConfig) http.RoundTripper {
+	base := defaultApiTransport()
+	if mock.url != "" {
+		base.TLSClientConfig = insecureTLSConfig()
+	}
+
+	var transport http.RoundTripper = base
+	if mock.fixtures != "" {
+		if mock.record {
+			transport = newApiFixtureTransport(mock.fixtures, base)
+		} else {
+			transport = newApiFixtureTransport(mock.fixtures, nil)
+		}
+	}
+
+	switch logLevel {
+	case apiLogLevelInfo, apiLogLevelDebug:
+		transport = &apiLoggingTransport{next: transport, debug: logLevel == apiLogLevelDebug}
+	}
+
+	// the cache wraps the logging transport so that only real API traffic is logged
+	if cacheTTL > 0 {
+		transport = newApiCachingTransport(transport, cacheTTL)
+	}
+
+	return transport
+}
+
+// defaultApiTransport mirrors the default transport of the Lacework API client
+func defaultApiTransport() *http.Transport {
+	return &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          100,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   63 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+	}
+}
+
+// apiLoggingTransport writes structured logs of the API requests and responses,
+// in debug mode, headers and bodies are included with secrets redacted
+type apiLoggingTransport struct {
+	next    http.RoundTripper
+	debug   bool
+	counter uint64
+}
+
+type apiLogEntry struct {
+	ID         uint64            `json:"id"`
+	Method     string            `json:"method"`
+	URL        string            `json:"url"`
+	StatusCode int               `json:"status_code,omitempty"`
+	DurationMs int64             `json:"duration_ms,omitempty"`
+	Headers    map[string]string `json:"headers,omitempty"`
+	Body       interface{}       `json:"body,omitempty"`
+	Error      string            `json:"error,omitempty"`
+}
+
+func (t *apiLoggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var (
+		id    = atomic.AddUint64(&t.counter, 1)
+		start = time.Now()
+		entry = apiLogEntry{ID: id, Method: req.Method, URL: req.URL.String()}
+	)
+
+	if t.debug {
+		entry.Headers = redactHeaders(req.Header)
+		if req.Body != nil && req.Body != http.NoBody {
+			body, err := io.ReadAll(req.Body)
+			req.Body.Close()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = io.NopCloser(bytes.NewReader(body))
+			entry.Body = redactBody(body)
+		}
+	}
+	t.write("request", entry)
+
+	res, err := t.next.RoundTrip(req)
+
+	entry = apiLogEntry{
+		ID:         id,
+		Method:     req.Method,
+		URL:        req.URL.String(),
+		DurationMs: time.Since(start).Milliseconds(),
+	}
+	if err != nil {
+		entry.Error = err.Error()
+		t.write("response", entry)
+		return res, err
+	}
+
+	entry.StatusCode = res.StatusCode
+	if t.debug {
+		entry.Headers = redactHeaders(res.Header)
+		if res.Body != nil {
+			body, err := io.ReadAll(res.Body)
+			res.Body.Close()
+			if err != nil {
+				return nil, err
+			}
+			res.Body = io.NopCloser(bytes.NewReader(body))
+			entry.Body = redactBody(body)
+		}
+	}
+	t.write("response", entry)
+
+	return res, nil
+}
+
+func (t *apiLoggingTransport) write(kind string, entry apiLogEntry) {
+	level := apiLogLevelInfo
+	if t.debug {
+		level = apiLogLevelDebug
+	}
+
+	line, err := json.Marshal(entry)
+	if err != nil {
+		log.Printf("[WARN] Unable to encode Lacework API %s log: %s\n", kind, err)
+		return
+	}
+	log.Printf("[%s] Lacework API %s: %s\n", level, kind, line)
+}
+
+func redactHeaders(headers http.Header) map[string]string {
+	redacted := make(map[string]string, len(headers))
+	for name, values := range headers {
+		if ContainsStr(apiLogSensitiveHeaders, strings.ToLower(name)) {
+			redacted[name] = apiLogRedacted
+			continue
+		}
+		redacted[name] = strings.Join(values, ", ")
+	}
+	return redacted
+}
+
+// redactBody returns the provided JSON body with the values of all sensitive
+// fields redacted, bodies that are not JSON are not logged
+func redactBody(body []byte) interface{} {
+	if len(body) == 0 {
+		return nil
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return fmt.Sprintf("<non-JSON body of %d bytes>", len(body))
+	}
+
+	redacted, err := json.Marshal(redactValue(v))
+	if err != nil {
+		return fmt.Sprintf("<body of %d bytes>", len(body))
+	}
+	if len(redacted) > apiLogMaxBodySize {
+		return fmt.Sprintf("%s... <truncated, %d bytes>", redacted[:apiLogMaxBodySize], len(redacted))
+	}
+	return json.RawMessage(redacted)
+}
+
+func redactValue(v interface{}) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		for k, field := range value {
+			if sensitiveApiLogField(k) {
+				if field != nil && field != "" {
+					value[k] = apiLogRedacted
+				}
+				continue
+			}
+			value[k] = redactValue(field)
+		}
+		return value
+	case []interface{}:
+		for i, item := range value {
+			value[i] = redactValue(item)
+		}
+		return value
+	default:
+		return value
+	}
+}
+
+func sensitiveApiLogField(name string) bool {
+	name = strings.ToLower(strings.ReplaceAll(name, "_", ""))
+	if ContainsStr(apiLogNonSensitiveFields, name) {
+		return false
+	}
+	for _, field := range apiLogSensitiveFields {
+		if strings.Contains(name, field) {
+			return true
+		}
+	}
+	return false
+}
+
+// apiCachingTransport caches the successful responses of GET requests for a short
+// period of time, so that many instances of the same data source only hit the API
+// once, concurrent requests to the same URL wait for the request in flight
+//
+// Any other request invalidates the whole cache since it might modify resources
+type apiCachingTransport struct {
+	next http.RoundTripper
+	ttl  time.Duration
+	now  func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]*apiCacheEntry
+}
+
+type apiCacheEntry struct {
+	// closed when the request in flight finished
+	ready     chan struct{}
+	expiresAt time.Time
+	response  *apiCachedResponse
+}
+
+type apiCachedResponse struct {
+	status     string
+	statusCode int
+	proto      string
+	protoMajor int
+	protoMinor int
+	header     http.Header
+	body       []byte
+}
+
+func newApiCachingTransport(next http.RoundTripper, ttl time.Duration) *apiCachingTransport {
+	return &apiCachingTransport{
+		next:    next,
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[string]*apiCacheEntry),
+	}
+}
+
+func (t *apiCachingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		t.invalidate()
+		return t.next.RoundTrip(req)
+	}
+
+	key := apiCacheKey(req)
+
+	t.mu.Lock()
+	entry, found := t.entries[key]
+	if found && entry.response != nil && t.now().After(entry.expiresAt) {
+		delete(t.entries, key)
+		found = false
+	}
+	if found {
+		t.mu.Unlock()
+
+		select {
+		case <-entry.ready:
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+
+		if entry.response != nil {
+			log.Printf("[DEBUG] Lacework API cache hit: %s %s\n", req.Method, req.URL)
+			return entry.response.build(req), nil
+		}
+
+		// the request in flight was not cached, send our own
+		return t.next.RoundTrip(req)
+	}
+
+	entry = &apiCacheEntry{ready: make(chan struct{})}
+	t.entries[key] = entry
+	t.mu.Unlock()
+
+	res, err := t.next.RoundTrip(req)
+
+	var cached *apiCachedResponse
+	if err == nil && res.StatusCode == http.StatusOK {
+		cached, err = newApiCachedResponse(res)
+		if err == nil {
+			res = cached.build(req)
+		}
+	}
+
+	t.mu.Lock()
+	if cached != nil {
+		entry.response = cached
+		entry.expiresAt = t.now().Add(t.ttl)
+	} else if t.entries[key] == entry {
+		delete(t.entries, key)
+	}
+	t.mu.Unlock()
+	close(entry.ready)
+
+	return res, err
+}
+
+func (t *apiCachingTransport) invalidate() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.entries = make(map[string]*apiCacheEntry)
+}
+
+// apiCacheKey returns the key of a request, the path and parameters of the URL
+// plus the headers that change the scope of the request, like the sub-account
+func apiCacheKey(req *http.Request) string {
+	return strings.Join([]string{
+		req.URL.String(),
+		req.Header.Get("Account-Name"),
+		req.Header.Get("Org-Access"),
+	}, "|")
+}
+
+func newApiCachedResponse(res *http.Response) (*apiCachedResponse, error) {
+	body, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiCachedResponse{
+		status:     res.Status,
+		statusCode: res.StatusCode,
+		proto:      res.Proto,
+		protoMajor: res.ProtoMajor,
+		protoMinor: res.ProtoMinor,
+		header:     res.Header.Clone(),
+		body:       body,
+	}, nil
+}
+
+// build returns a new response from the cache, every caller gets its own body
+func (c *apiCachedResponse) build(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        c.status,
+		StatusCode:    c.statusCode,
+		Proto:         c.proto,
+		ProtoMajor:    c.protoMajor,
+		ProtoMinor:    c.protoMinor,
+		Header:        c.header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(c.body)),
+		ContentLength: int64(len(c.body)),
+		Request:       req,
+	}
+}
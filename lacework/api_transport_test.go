@@ -0,0 +1,197 @@
+package lacework
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedactBody(t *testing.T) {
+	redacted := redactBody([]byte(`{
+		"name": "my-integration",
+		"data": {
+			"crossAccountCredentials": {"externalId": "abc", "roleArn": "arn"},
+			"secretAccessKey": "super-secret",
+			"tokenAlias": "k8s",
+			"accessToken": "",
+			"apiKeys": ["key-1"]
+		},
+		"items": [{"api_token": "tkn", "fieldKey": "accountIds"}]
+	}`))
+
+	assert.JSONEq(t, `{
+		"name": "my-integration",
+		"data": {
+			"crossAccountCredentials": "REDACTED",
+			"secretAccessKey": "REDACTED",
+			"tokenAlias": "k8s",
+			"accessToken": "",
+			"apiKeys": "REDACTED"
+		},
+		"items": [{"api_token": "REDACTED", "fieldKey": "accountIds"}]
+	}`, string(redacted.(json.RawMessage)))
+	assert.Equal(t, "<non-JSON body of 5 bytes>", redactBody([]byte("hello")))
+	assert.Nil(t, redactBody(nil))
+}
+
+func TestRedactHeaders(t *testing.T) {
+	headers := http.Header{}
+	headers.Set("Authorization", "Bearer abc")
+	headers.Set("X-LW-UAKS", "secret")
+	headers.Set("Content-Type", "application/json")
+
+	assert.Equal(t, map[string]string{
+		"Authorization": "REDACTED",
+		"X-Lw-Uaks":     "REDACTED",
+		"Content-Type":  "application/json",
+	}, redactHeaders(headers))
+}
+
+func TestApiLoggingTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, `{"secret":"request-secret"}`, string(body), "request body must be preserved")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"token":"response-secret","expiresAt":"2024-01-01T00:00:00Z"}`))
+	}))
+	defer server.Close()
+
+	var logs bytes.Buffer
+	log.SetOutput(&logs)
+	defer log.SetOutput(os.Stderr)
+
+	client := &http.Client{Transport: newApiTransport(apiLogLevelDebug, 0, apiMockConfig{})}
+	req, _ := http.NewRequest("POST", server.URL, strings.NewReader(`{"secret":"request-secret"}`))
+	req.Header.Set("Authorization", "Bearer request-token")
+
+	res, err := client.Do(req)
+	if assert.NoError(t, err) {
+		body, _ := io.ReadAll(res.Body)
+		assert.Contains(t, string(body), "response-secret", "response body must be preserved")
+	}
+
+	output := logs.String()
+	assert.Contains(t, output, "[DEBUG] Lacework API request:")
+	assert.Contains(t, output, "[DEBUG] Lacework API response:")
+	assert.Contains(t, output, `"status_code":200`)
+	assert.Contains(t, output, `"expiresAt":"2024-01-01T00:00:00Z"`)
+	assert.NotContains(t, output, "request-secret")
+	assert.NotContains(t, output, "request-token")
+	assert.NotContains(t, output, "response-secret")
+}
+
+func TestApiCachingTransport(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&requests, 1)
+		if r.URL.Path == "/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprintf(w, `{"request":%d}`, n)
+	}))
+	defer server.Close()
+
+	var (
+		now       = time.Now()
+		transport = newApiCachingTransport(http.DefaultTransport, 10*time.Second)
+		client    = &http.Client{Transport: transport}
+		get       = func(path string) string {
+			res, err := client.Get(server.URL + path)
+			if !assert.NoError(t, err) {
+				return ""
+			}
+			defer res.Body.Close()
+			body, _ := io.ReadAll(res.Body)
+			return string(body)
+		}
+	)
+	transport.now = func() time.Time { return now }
+
+	// the same path and params are served from the cache
+	assert.Equal(t, `{"request":1}`, get("/tokens?name=a"))
+	assert.Equal(t, `{"request":1}`, get("/tokens?name=a"))
+	assert.Equal(t, `{"request":2}`, get("/tokens?name=b"))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+
+	// unsuccessful responses are not cached
+	get("/missing")
+	get("/missing")
+	assert.Equal(t, int32(4), atomic.LoadInt32(&requests))
+
+	// responses expire after the TTL
+	now = now.Add(11 * time.Second)
+	assert.Equal(t, `{"request":5}`, get("/tokens?name=a"))
+	assert.Equal(t, `{"request":5}`, get("/tokens?name=a"))
+
+	// any other request invalidates the cache
+	res, err := client.Post(server.URL+"/tokens", "application/json", strings.NewReader(`{}`))
+	if assert.NoError(t, err) {
+		res.Body.Close()
+	}
+	assert.Equal(t, `{"request":7}`, get("/tokens?name=a"))
+}
+
+func TestApiCachingTransportConcurrentRequests(t *testing.T) {
+	var (
+		requests int32
+		release  = make(chan struct{})
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		<-release
+		w.Write([]byte(`{"ok":true}`))
+	}))
+	defer server.Close()
+
+	var (
+		client = &http.Client{Transport: newApiCachingTransport(http.DefaultTransport, time.Minute)}
+		wg     sync.WaitGroup
+	)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res, err := client.Get(server.URL + "/tokens")
+			if assert.NoError(t, err) {
+				body, _ := io.ReadAll(res.Body)
+				res.Body.Close()
+				assert.Equal(t, `{"ok":true}`, string(body))
+			}
+		}()
+	}
+
+	// give the goroutines time to queue behind the request in flight
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+}
+
+func TestApiCacheTTLDefaultFunc(t *testing.T) {
+	// the cache is opt-in
+	t.Setenv("LW_API_CACHE_TTL", "")
+	ttl, err := apiCacheTTLDefaultFunc()
+	if assert.NoError(t, err) {
+		assert.Equal(t, 0, ttl)
+	}
+
+	t.Setenv("LW_API_CACHE_TTL", "30")
+	ttl, err = apiCacheTTLDefaultFunc()
+	if assert.NoError(t, err) {
+		assert.Equal(t, 30, ttl)
+	}
+}
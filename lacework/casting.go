@@ -41,6 +41,14 @@ func castStringSliceToInterface(strs []string) []interface{} {
 	return arr
 }
 
+func castStringMap(iMap map[string]interface{}) map[string]string {
+	m := make(map[string]string, len(iMap))
+	for k, v := range iMap {
+		m[k] = v.(string)
+	}
+	return m
+}
+
 // extract an attribute from the provided ResourceData and convert it into an array of map of strings
 // with string keys. (needed for API v2 ContainerRegistry Limits)
 //
@@ -134,3 +142,13 @@ func ContainsStr(array []string, expected string) bool {
 	}
 	return false
 }
+
+// containsFold returns true if the array contains the expected string, case insensitive
+func containsFold(array []string, expected string) bool {
+	for _, value := range array {
+		if strings.EqualFold(expected, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -88,6 +88,13 @@ func TestContainsStr(t *testing.T) {
 	assert.False(t, ContainsStr([]string{}, "foo"))
 }
 
+func TestContainsFold(t *testing.T) {
+	assert.True(t, containsFold([]string{"NewExternalServerIp"}, "newexternalserverip"))
+	assert.True(t, containsFold([]string{"a", "B"}, "b"))
+	assert.False(t, containsFold([]string{"abc"}, "bc"))
+	assert.False(t, containsFold([]string{}, "foo"))
+}
+
 func TestCastAttributeToArrayOfStringKeyMapOfStrings(t *testing.T) {
 	var (
 		mockLabels = []map[string]string{
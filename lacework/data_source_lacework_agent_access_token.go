@@ -5,8 +5,6 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-
-	"github.com/lacework/go-sdk/api"
 )
 
 func dataSourceLaceworkAgentAccessToken() *schema.Resource {
@@ -27,7 +25,7 @@ func dataSourceLaceworkAgentAccessToken() *schema.Resource {
 }
 
 func dataSourceLaceworkAgentAccessTokenRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Lookup agent access token.")
 	response, err := lacework.V2.AgentAccessTokens.List()
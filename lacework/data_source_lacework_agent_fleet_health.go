@@ -0,0 +1,190 @@
+package lacework
+
+import (
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/lacework/go-sdk/api"
+)
+
+func dataSourceLaceworkAgentFleetHealth() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceLaceworkAgentFleetHealthRead,
+		Schema: map[string]*schema.Schema{
+			"inactive_threshold_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      7,
+				ValidateFunc: validation.IntBetween(1, api.V2ApiMaxSearchHistoryDays),
+				Description:  "The number of days without an update after which an agent is considered inactive",
+			},
+			"total_agents": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"active_agents": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"inactive_agents": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"inactive_hostnames": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"status_distribution": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"version_distribution": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+		},
+	}
+}
+
+func dataSourceLaceworkAgentFleetHealthRead(d *schema.ResourceData, meta interface{}) error {
+	lacework := meta.(*providerMeta).client
+
+	log.Printf("[INFO] Searching agent information.")
+	agents, err := searchAgentInfo(func(filter api.SearchFilter) ([]api.AgentInfo, error) {
+		var (
+			response api.AgentInfoResponse
+			agents   = make([]api.AgentInfo, 0)
+		)
+		err := lacework.V2.AgentInfo.Search(&response, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		for {
+			agents = append(agents, response.Data...)
+
+			pageOk, err := lacework.NextPage(&response)
+			if err != nil {
+				return nil, err
+			}
+			if !pageOk {
+				break
+			}
+		}
+		return agents, nil
+	}, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+
+	threshold := time.Duration(d.Get("inactive_threshold_days").(int)) * 24 * time.Hour
+	health := aggregateAgentFleetHealth(agents, time.Now().UTC().Add(-threshold))
+
+	log.Printf("[INFO] Agent fleet health aggregated. total=%d, active=%d, inactive=%d",
+		health.Total, health.Active, health.Inactive)
+
+	d.SetId(time.Now().UTC().String())
+	d.Set("total_agents", health.Total)
+	d.Set("active_agents", health.Active)
+	d.Set("inactive_agents", health.Inactive)
+	d.Set("inactive_hostnames", health.InactiveHostnames)
+	d.Set("status_distribution", health.Statuses)
+	d.Set("version_distribution", health.Versions)
+
+	return nil
+}
+
+// searchAgentInfo searches the agents that reported in the history kept by the Lacework API.
+// Without a time filter the API only returns the agents that reported recently, which are
+// never inactive, and a search covers at most a few days, so the search walks back from now
+// one window at a time and keeps the latest information of every agent
+func searchAgentInfo(search func(api.SearchFilter) ([]api.AgentInfo, error), now time.Time) ([]api.AgentInfo, error) {
+	var (
+		latest = make(map[int]api.AgentInfo)
+		oldest = now.AddDate(0, 0, -api.V2ApiMaxSearchHistoryDays)
+	)
+
+	for end := now; end.After(oldest); end = end.AddDate(0, 0, -api.V2ApiMaxSearchWindowDays) {
+		var (
+			endTime   = end
+			startTime = end.AddDate(0, 0, -api.V2ApiMaxSearchWindowDays)
+		)
+		if startTime.Before(oldest) {
+			startTime = oldest
+		}
+
+		log.Printf("[DEBUG] Searching agent information. start_time=%s, end_time=%s",
+			startTime.Format(time.RFC3339), endTime.Format(time.RFC3339))
+		agents, err := search(api.SearchFilter{
+			TimeFilter: &api.TimeFilter{
+				StartTime: &startTime,
+				EndTime:   &endTime,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, agent := range agents {
+			if current, ok := latest[agent.Mid]; !ok || agent.LastUpdate.After(current.LastUpdate) {
+				latest[agent.Mid] = agent
+			}
+		}
+	}
+
+	agents := make([]api.AgentInfo, 0, len(latest))
+	for _, agent := range latest {
+		agents = append(agents, agent)
+	}
+	sort.Slice(agents, func(i, j int) bool { return agents[i].Mid < agents[j].Mid })
+	return agents, nil
+}
+
+type agentFleetHealth struct {
+	Total             int
+	Active            int
+	Inactive          int
+	InactiveHostnames []string
+	Statuses          map[string]int
+	Versions          map[string]int
+}
+
+// aggregateAgentFleetHealth summarizes the provided agents, any agent whose last
+// update happened before the provided time is accounted as inactive
+func aggregateAgentFleetHealth(agents []api.AgentInfo, inactiveBefore time.Time) agentFleetHealth {
+	health := agentFleetHealth{
+		Total:             len(agents),
+		InactiveHostnames: make([]string, 0),
+		Statuses:          make(map[string]int),
+		Versions:          make(map[string]int),
+	}
+
+	for _, agent := range agents {
+		health.Statuses[strings.ToLower(agent.Status)]++
+
+		if agent.AgentVersion != "" {
+			health.Versions[agent.AgentVersion]++
+		}
+
+		if agent.LastUpdate.Before(inactiveBefore) {
+			health.Inactive++
+			health.InactiveHostnames = append(health.InactiveHostnames, agent.Hostname)
+			continue
+		}
+
+		if strings.EqualFold(agent.Status, "active") {
+			health.Active++
+		}
+	}
+
+	sort.Strings(health.InactiveHostnames)
+	return health
+}
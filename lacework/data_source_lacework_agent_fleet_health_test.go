@@ -0,0 +1,94 @@
+package lacework
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lacework/go-sdk/api"
+)
+
+func TestAggregateAgentFleetHealth(t *testing.T) {
+	var (
+		now    = time.Now().UTC()
+		agents = []api.AgentInfo{
+			{Hostname: "host-a", Status: "Active", AgentVersion: "6.8.0", LastUpdate: now},
+			{Hostname: "host-b", Status: "ACTIVE", AgentVersion: "6.8.0", LastUpdate: now.Add(-time.Hour)},
+			{Hostname: "host-c", Status: "Active", AgentVersion: "6.7.0", LastUpdate: now.AddDate(0, 0, -10)},
+			{Hostname: "host-d", Status: "Inactive", AgentVersion: "6.7.0", LastUpdate: now},
+		}
+		health = aggregateAgentFleetHealth(agents, now.AddDate(0, 0, -7))
+	)
+
+	assert.Equal(t, 4, health.Total)
+	assert.Equal(t, 2, health.Active)
+	assert.Equal(t, 1, health.Inactive)
+	assert.Equal(t, []string{"host-c"}, health.InactiveHostnames)
+	assert.Equal(t, map[string]int{"active": 3, "inactive": 1}, health.Statuses)
+	assert.Equal(t, map[string]int{"6.8.0": 2, "6.7.0": 2}, health.Versions)
+}
+
+func TestAggregateAgentFleetHealthEmpty(t *testing.T) {
+	health := aggregateAgentFleetHealth([]api.AgentInfo{}, time.Now())
+
+	assert.Equal(t, 0, health.Total)
+	assert.Empty(t, health.InactiveHostnames)
+	assert.Empty(t, health.Statuses)
+	assert.Empty(t, health.Versions)
+}
+
+func TestSearchAgentInfo(t *testing.T) {
+	var (
+		now     = time.Date(2024, 5, 14, 10, 0, 0, 0, time.UTC)
+		filters []api.SearchFilter
+	)
+
+	agents, err := searchAgentInfo(func(filter api.SearchFilter) ([]api.AgentInfo, error) {
+		filters = append(filters, filter)
+		switch len(filters) {
+		case 1:
+			return []api.AgentInfo{{Mid: 1, Hostname: "host-a", LastUpdate: now.Add(-time.Hour)}}, nil
+		case 2:
+			// agents report in every window, only their latest information is kept
+			return []api.AgentInfo{
+				{Mid: 1, Hostname: "host-a", LastUpdate: now.AddDate(0, 0, -8)},
+				{Mid: 2, Hostname: "host-b", LastUpdate: now.AddDate(0, 0, -9)},
+			}, nil
+		case 10:
+			return []api.AgentInfo{{Mid: 3, Hostname: "host-c", LastUpdate: now.AddDate(0, 0, -65)}}, nil
+		}
+		return nil, nil
+	}, now)
+
+	if assert.Nil(t, err) {
+		assert.Equal(t, []api.AgentInfo{
+			{Mid: 1, Hostname: "host-a", LastUpdate: now.Add(-time.Hour)},
+			{Mid: 2, Hostname: "host-b", LastUpdate: now.AddDate(0, 0, -9)},
+			{Mid: 3, Hostname: "host-c", LastUpdate: now.AddDate(0, 0, -65)},
+		}, agents)
+	}
+
+	// the windows cover the whole history kept by the API without exceeding the maximum window
+	if assert.Len(t, filters, 14) {
+		assert.Equal(t, now, *filters[0].TimeFilter.EndTime)
+		assert.Equal(t, now.AddDate(0, 0, -7), *filters[0].TimeFilter.StartTime)
+		assert.Equal(t, now.AddDate(0, 0, -7), *filters[1].TimeFilter.EndTime)
+		assert.Equal(t, now.AddDate(0, 0, -91), *filters[13].TimeFilter.EndTime)
+		assert.Equal(t, now.AddDate(0, 0, -92), *filters[13].TimeFilter.StartTime)
+		for _, filter := range filters {
+			window := filter.TimeFilter.EndTime.Sub(*filter.TimeFilter.StartTime)
+			assert.LessOrEqual(t, window, 7*24*time.Hour)
+		}
+	}
+}
+
+func TestSearchAgentInfoError(t *testing.T) {
+	_, err := searchAgentInfo(func(api.SearchFilter) ([]api.AgentInfo, error) {
+		return nil, errors.New("rate limit exceeded")
+	}, time.Now())
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "rate limit exceeded", err.Error())
+	}
+}
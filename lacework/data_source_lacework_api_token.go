@@ -4,8 +4,6 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-
-	"github.com/lacework/go-sdk/api"
 )
 
 func dataSourceLaceworkApiToken() *schema.Resource {
@@ -22,7 +20,7 @@ func dataSourceLaceworkApiToken() *schema.Resource {
 }
 
 func dataSourceLaceworkApiTokenRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	response, err := lacework.GenerateToken()
 	if err != nil {
@@ -0,0 +1,263 @@
+package lacework
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/lacework/go-sdk/api"
+)
+
+// eventsDefaultTimeWindow is the time range of events listed when no start time is provided
+const eventsDefaultTimeWindow = 24 * time.Hour
+
+func dataSourceLaceworkEvents() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceLaceworkEventsRead,
+		Schema: map[string]*schema.Schema{
+			"start_time": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.IsRFC3339Time,
+				ConflictsWith: []string{"time_window"},
+				Description:   "The start of the time range of the events in RFC3339 format",
+			},
+			"end_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+				Description:  "The end of the time range of the events in RFC3339 format, defaults to now",
+			},
+			"time_window": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: ValidDuration(),
+				ConflictsWith:    []string{"start_time"},
+				Description:      "The duration of the time range of the events that ends at end_time, like 1h or 72h, defaults to 24h",
+			},
+			"severities": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: ValidSeverity(),
+				},
+				Description: "Only list events with one of these severities",
+			},
+			"event_types": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Only list events of one of these types",
+			},
+			"event_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"events": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"event_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"event_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"event_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"severity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"summary": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"category": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"policy_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"start_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"end_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceLaceworkEventsRead(d *schema.ResourceData, meta interface{}) error {
+	lacework := meta.(*providerMeta).client
+
+	start, end, err := getEventsTimeRange(d, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+
+	filter := eventsFilter{
+		Severities: castStringSlice(d.Get("severities").(*schema.Set).List()),
+		EventTypes: castStringSlice(d.Get("event_types").(*schema.Set).List()),
+	}
+
+	log.Printf("[INFO] Searching events. start_time=%s, end_time=%s",
+		start.Format(time.RFC3339), end.Format(time.RFC3339))
+	response, err := lacework.V2.Alerts.SearchAll(filter.searchFilter(start, end))
+	if err != nil {
+		return err
+	}
+
+	// the API only returns the matching events, the filters are applied again to the
+	// response so that events that do not match them are never exported
+	events := filterEvents(response.Data, filter)
+
+	log.Printf("[INFO] Events listed. total=%d, matched=%d", len(response.Data), len(events))
+
+	var (
+		eventIDs = make([]string, 0, len(events))
+		values   = make([]map[string]interface{}, 0, len(events))
+	)
+	for _, event := range events {
+		eventID := strconv.Itoa(event.ID)
+		eventIDs = append(eventIDs, eventID)
+		values = append(values, map[string]interface{}{
+			"event_id":    eventID,
+			"event_name":  event.Name,
+			"event_type":  event.Type,
+			"severity":    normalizeSeverity(event.Severity),
+			"status":      event.Status,
+			"summary":     event.Info.Subject,
+			"description": event.Info.Description,
+			"category":    event.DerivedFields.Category,
+			"policy_id":   event.PolicyID,
+			"start_time":  event.StartTime,
+			"end_time":    event.EndTime,
+		})
+	}
+
+	d.SetId(time.Now().UTC().String())
+	d.Set("event_ids", eventIDs)
+	d.Set("events", values)
+
+	return nil
+}
+
+// getEventsTimeRange returns the time range of the events to list, the range ends at end_time
+// or now, and starts at start_time or at the configured time window before the end
+func getEventsTimeRange(d *schema.ResourceData, now time.Time) (start, end time.Time, err error) {
+	end = now
+	if endTime := d.Get("end_time").(string); endTime != "" {
+		if end, err = time.Parse(time.RFC3339, endTime); err != nil {
+			return
+		}
+	}
+
+	if startTime := d.Get("start_time").(string); startTime != "" {
+		if start, err = time.Parse(time.RFC3339, startTime); err != nil {
+			return
+		}
+	} else {
+		window := eventsDefaultTimeWindow
+		if timeWindow := d.Get("time_window").(string); timeWindow != "" {
+			if window, err = time.ParseDuration(timeWindow); err != nil {
+				return
+			}
+		}
+		start = end.Add(-window)
+	}
+
+	if !start.Before(end) {
+		err = fmt.Errorf("the start of the time range (%s) must be before its end (%s)",
+			start.Format(time.RFC3339), end.Format(time.RFC3339))
+	}
+	start, end = start.UTC(), end.UTC()
+	return
+}
+
+type eventsFilter struct {
+	Severities []string
+	EventTypes []string
+}
+
+// searchFilter returns the search filter of the events of the time range, the severities
+// are sent in title case, the format of the Alerts API
+func (f eventsFilter) searchFilter(start, end time.Time) api.SearchFilter {
+	filter := api.SearchFilter{
+		TimeFilter: &api.TimeFilter{StartTime: &start, EndTime: &end},
+	}
+
+	if len(f.Severities) != 0 {
+		severities := make([]string, 0, len(f.Severities))
+		for _, value := range f.Severities {
+			severities = append(severities, newSeverity(value).Title())
+		}
+		sort.Strings(severities)
+		filter.Filters = append(filter.Filters, api.Filter{
+			Expression: "in",
+			Field:      "severity",
+			Values:     severities,
+		})
+	}
+
+	if len(f.EventTypes) != 0 {
+		eventTypes := append([]string{}, f.EventTypes...)
+		sort.Strings(eventTypes)
+		filter.Filters = append(filter.Filters, api.Filter{
+			Expression: "in",
+			Field:      "alertType",
+			Values:     eventTypes,
+		})
+	}
+	return filter
+}
+
+// filterEvents returns the events that match all the provided filters, sorted by
+// event id, any empty filter matches every event
+func filterEvents(events []api.Alert, filter eventsFilter) []api.Alert {
+	matched := make([]api.Alert, 0)
+	for _, event := range events {
+		if len(filter.Severities) != 0 && !severityIn(filter.Severities, event.Severity) {
+			continue
+		}
+
+		if len(filter.EventTypes) != 0 && !containsFold(filter.EventTypes, event.Type) {
+			continue
+		}
+
+		matched = append(matched, event)
+	}
+
+	sort.Slice(matched, func(i, j int) bool {
+		return matched[i].ID < matched[j].ID
+	})
+	return matched
+}
@@ -0,0 +1,103 @@
+package lacework
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lacework/go-sdk/api"
+)
+
+func TestGetEventsTimeRange(t *testing.T) {
+	var (
+		resource = dataSourceLaceworkEvents()
+		now      = time.Date(2026, time.October, 14, 12, 0, 0, 0, time.UTC)
+	)
+
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{})
+	start, end, err := getEventsTimeRange(d, now)
+	if assert.Nil(t, err) {
+		assert.Equal(t, now.Add(-24*time.Hour), start)
+		assert.Equal(t, now, end)
+	}
+
+	d = schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"end_time":    "2026-10-01T00:00:00Z",
+		"time_window": "72h",
+	})
+	start, end, err = getEventsTimeRange(d, now)
+	if assert.Nil(t, err) {
+		assert.Equal(t, time.Date(2026, time.September, 28, 0, 0, 0, 0, time.UTC), start)
+		assert.Equal(t, time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC), end)
+	}
+
+	d = schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"start_time": "2026-10-14T10:00:00+02:00",
+	})
+	start, end, err = getEventsTimeRange(d, now)
+	if assert.Nil(t, err) {
+		assert.Equal(t, time.Date(2026, time.October, 14, 8, 0, 0, 0, time.UTC), start)
+		assert.Equal(t, now, end)
+	}
+
+	d = schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"start_time": "2026-10-15T00:00:00Z",
+	})
+	_, _, err = getEventsTimeRange(d, now)
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "must be before its end")
+	}
+}
+
+func TestFilterEvents(t *testing.T) {
+	events := []api.Alert{
+		{ID: 3, Type: "NewExternalServerIp", Severity: "Medium"},
+		{ID: 1, Type: "CloudActivityLogIngestionFailed", Severity: "Critical"},
+		{ID: 2, Type: "NewExternalServerIp", Severity: "High"},
+	}
+
+	all := filterEvents(events, eventsFilter{})
+	if assert.Len(t, all, 3) {
+		assert.Equal(t, 1, all[0].ID)
+		assert.Equal(t, 3, all[2].ID)
+	}
+
+	bySeverity := filterEvents(events, eventsFilter{Severities: []string{"critical", "HIGH"}})
+	if assert.Len(t, bySeverity, 2) {
+		assert.Equal(t, 1, bySeverity[0].ID)
+		assert.Equal(t, 2, bySeverity[1].ID)
+	}
+
+	byType := filterEvents(events, eventsFilter{
+		Severities: []string{"medium"},
+		EventTypes: []string{"newexternalserverip"},
+	})
+	if assert.Len(t, byType, 1) {
+		assert.Equal(t, 3, byType[0].ID)
+	}
+
+	assert.Empty(t, filterEvents(events, eventsFilter{EventTypes: []string{"Unknown"}}))
+}
+
+func TestEventsSearchFilter(t *testing.T) {
+	var (
+		start = time.Date(2026, time.October, 13, 8, 0, 0, 0, time.UTC)
+		end   = time.Date(2026, time.October, 14, 8, 0, 0, 0, time.UTC)
+	)
+
+	filter := eventsFilter{}.searchFilter(start, end)
+	assert.Equal(t, start, *filter.TimeFilter.StartTime)
+	assert.Equal(t, end, *filter.TimeFilter.EndTime)
+	assert.Empty(t, filter.Filters)
+
+	filter = eventsFilter{
+		Severities: []string{"high", "CRITICAL"},
+		EventTypes: []string{"NewExternalServerIp", "CloudActivityLogIngestionFailed"},
+	}.searchFilter(start, end)
+	assert.Equal(t, []api.Filter{
+		{Expression: "in", Field: "severity", Values: []string{"Critical", "High"}},
+		{Expression: "in", Field: "alertType", Values: []string{"CloudActivityLogIngestionFailed", "NewExternalServerIp"}},
+	}, filter.Filters)
+}
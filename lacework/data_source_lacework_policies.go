@@ -0,0 +1,215 @@
+package lacework
+
+import (
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/lacework/go-sdk/api"
+)
+
+// catalogBuiltinOwner is the owner of the built-in policies and queries
+const catalogBuiltinOwner = "Lacework"
+
+func dataSourceLaceworkPolicies() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceLaceworkPoliciesRead,
+		Schema: map[string]*schema.Schema{
+			"severities": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: ValidSeverity(),
+				},
+				Description: "Only list policies with one of these severities",
+			},
+			"tags": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Only list policies that have at least one of these tags",
+			},
+			"state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled"}, true),
+				Description:  "Only list policies in this state, either enabled or disabled",
+			},
+			"source": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"builtin", "custom"}, true),
+				Description:  "Only list built-in or custom policies",
+			},
+			"policy_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"policies": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"policy_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"policy_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"query_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"title": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"severity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"alert_enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"eval_frequency": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"owner": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"custom": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceLaceworkPoliciesRead(d *schema.ResourceData, meta interface{}) error {
+	lacework := meta.(*providerMeta).client
+
+	log.Printf("[INFO] Listing policies.")
+	response, err := lacework.V2.Policy.List()
+	if err != nil {
+		return err
+	}
+
+	filter := policiesFilter{
+		Severities: castStringSlice(d.Get("severities").(*schema.Set).List()),
+		Tags:       castStringSlice(d.Get("tags").(*schema.Set).List()),
+		State:      d.Get("state").(string),
+		Source:     d.Get("source").(string),
+	}
+	policies := filterPolicies(response.Data, filter)
+
+	log.Printf("[INFO] Policies listed. total=%d, matched=%d", len(response.Data), len(policies))
+
+	var (
+		policyIDs = make([]string, 0, len(policies))
+		values    = make([]map[string]interface{}, 0, len(policies))
+	)
+	for _, policy := range policies {
+		policyIDs = append(policyIDs, policy.PolicyID)
+		values = append(values, map[string]interface{}{
+			"policy_id":      policy.PolicyID,
+			"policy_type":    policy.PolicyType,
+			"query_id":       policy.QueryID,
+			"title":          policy.Title,
+			"severity":       normalizeSeverity(policy.Severity),
+			"enabled":        policy.Enabled,
+			"alert_enabled":  policy.AlertEnabled,
+			"eval_frequency": policy.EvalFrequency,
+			"tags":           policy.Tags,
+			"owner":          policy.Owner,
+			"custom":         policy.Owner != catalogBuiltinOwner,
+		})
+	}
+
+	d.SetId(time.Now().UTC().String())
+	d.Set("policy_ids", policyIDs)
+	d.Set("policies", values)
+
+	return nil
+}
+
+type policiesFilter struct {
+	Severities []string
+	Tags       []string
+	State      string
+	Source     string
+}
+
+// filterPolicies returns the policies that match all the provided filters, sorted by
+// policy id, any empty filter matches every policy
+func filterPolicies(policies []api.Policy, filter policiesFilter) []api.Policy {
+	matched := make([]api.Policy, 0)
+	for _, policy := range policies {
+		if len(filter.Severities) != 0 && !severityIn(filter.Severities, policy.Severity) {
+			continue
+		}
+
+		if len(filter.Tags) != 0 && !policyHasAnyTag(policy, filter.Tags) {
+			continue
+		}
+
+		if filter.State != "" && strings.EqualFold(filter.State, "enabled") != policy.Enabled {
+			continue
+		}
+
+		if !matchesCatalogSource(filter.Source, policy.Owner) {
+			continue
+		}
+
+		matched = append(matched, policy)
+	}
+
+	sort.Slice(matched, func(i, j int) bool {
+		return matched[i].PolicyID < matched[j].PolicyID
+	})
+	return matched
+}
+
+func policyHasAnyTag(policy api.Policy, tags []string) bool {
+	for _, tag := range tags {
+		if policy.HasTag(tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesCatalogSource returns true if a policy or query with the provided owner
+// matches the source filter, built-in policies and queries are owned by Lacework
+func matchesCatalogSource(source, owner string) bool {
+	switch strings.ToLower(source) {
+	case "builtin":
+		return owner == catalogBuiltinOwner
+	case "custom":
+		return owner != catalogBuiltinOwner
+	default:
+		return true
+	}
+}
@@ -0,0 +1,88 @@
+package lacework
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lacework/go-sdk/api"
+)
+
+var catalogTestPolicies = []api.Policy{
+	{PolicyID: "lacework-global-2", Severity: "Critical", Enabled: true, Owner: "Lacework",
+		Tags: []string{"domain:AWS", "security:compliance"}},
+	{PolicyID: "lacework-global-1", Severity: "High", Enabled: false, Owner: "Lacework",
+		Tags: []string{"domain:GCP"}},
+	{PolicyID: "custom-1", Severity: "low", Enabled: true, Owner: "user@example.com",
+		Tags: []string{"custom", "domain:AWS"}},
+}
+
+func TestFilterPoliciesNoFilters(t *testing.T) {
+	policies := filterPolicies(catalogTestPolicies, policiesFilter{})
+	if assert.Len(t, policies, 3) {
+		assert.Equal(t, "custom-1", policies[0].PolicyID)
+		assert.Equal(t, "lacework-global-1", policies[1].PolicyID)
+		assert.Equal(t, "lacework-global-2", policies[2].PolicyID)
+	}
+}
+
+func TestFilterPolicies(t *testing.T) {
+	cases := []struct {
+		name     string
+		filter   policiesFilter
+		expected []string
+	}{
+		{"severities are case insensitive",
+			policiesFilter{Severities: []string{"critical", "LOW"}},
+			[]string{"custom-1", "lacework-global-2"}},
+		{"any tag",
+			policiesFilter{Tags: []string{"domain:GCP", "custom"}},
+			[]string{"custom-1", "lacework-global-1"}},
+		{"enabled",
+			policiesFilter{State: "Enabled"},
+			[]string{"custom-1", "lacework-global-2"}},
+		{"disabled",
+			policiesFilter{State: "disabled"},
+			[]string{"lacework-global-1"}},
+		{"builtin",
+			policiesFilter{Source: "builtin"},
+			[]string{"lacework-global-1", "lacework-global-2"}},
+		{"custom",
+			policiesFilter{Source: "custom"},
+			[]string{"custom-1"}},
+		{"all filters",
+			policiesFilter{Severities: []string{"critical"}, Tags: []string{"domain:AWS"}, State: "enabled", Source: "builtin"},
+			[]string{"lacework-global-2"}},
+		{"no match",
+			policiesFilter{Severities: []string{"info"}},
+			[]string{}},
+	}
+
+	for _, c := range cases {
+		policyIDs := make([]string, 0)
+		for _, policy := range filterPolicies(catalogTestPolicies, c.filter) {
+			policyIDs = append(policyIDs, policy.PolicyID)
+		}
+		assert.Equal(t, c.expected, policyIDs, c.name)
+	}
+}
+
+func TestFilterQueries(t *testing.T) {
+	queries := []api.Query{
+		{QueryID: "LW_Global_AWS_CTA_2", Owner: "Lacework"},
+		{QueryID: "LW_Global_AWS_CTA_1", Owner: "Lacework"},
+		{QueryID: "MyCustomQuery", Owner: "user@example.com"},
+	}
+
+	assert.Len(t, filterQueries(queries, ""), 3)
+
+	builtin := filterQueries(queries, "builtin")
+	if assert.Len(t, builtin, 2) {
+		assert.Equal(t, "LW_Global_AWS_CTA_1", builtin[0].QueryID)
+	}
+
+	custom := filterQueries(queries, "CUSTOM")
+	if assert.Len(t, custom, 1) {
+		assert.Equal(t, "MyCustomQuery", custom[0].QueryID)
+	}
+}
@@ -0,0 +1,114 @@
+package lacework
+
+import (
+	"log"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/lacework/go-sdk/api"
+)
+
+func dataSourceLaceworkQueries() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceLaceworkQueriesRead,
+		Schema: map[string]*schema.Schema{
+			"source": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"builtin", "custom"}, true),
+				Description:  "Only list built-in or custom queries",
+			},
+			"query_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"queries": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"query_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"query_text": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"owner": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"custom": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"last_update_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_update_user": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceLaceworkQueriesRead(d *schema.ResourceData, meta interface{}) error {
+	lacework := meta.(*providerMeta).client
+
+	log.Printf("[INFO] Listing queries.")
+	response, err := lacework.V2.Query.List()
+	if err != nil {
+		return err
+	}
+
+	queries := filterQueries(response.Data, d.Get("source").(string))
+
+	log.Printf("[INFO] Queries listed. total=%d, matched=%d", len(response.Data), len(queries))
+
+	var (
+		queryIDs = make([]string, 0, len(queries))
+		values   = make([]map[string]interface{}, 0, len(queries))
+	)
+	for _, query := range queries {
+		queryIDs = append(queryIDs, query.QueryID)
+		values = append(values, map[string]interface{}{
+			"query_id":         query.QueryID,
+			"query_text":       query.QueryText,
+			"owner":            query.Owner,
+			"custom":           query.Owner != catalogBuiltinOwner,
+			"last_update_time": query.LastUpdateTime,
+			"last_update_user": query.LastUpdateUser,
+		})
+	}
+
+	d.SetId(time.Now().UTC().String())
+	d.Set("query_ids", queryIDs)
+	d.Set("queries", values)
+
+	return nil
+}
+
+// filterQueries returns the queries that match the provided source, sorted by query id
+func filterQueries(queries []api.Query, source string) []api.Query {
+	matched := make([]api.Query, 0)
+	for _, query := range queries {
+		if matchesCatalogSource(source, query.Owner) {
+			matched = append(matched, query)
+		}
+	}
+
+	sort.Slice(matched, func(i, j int) bool {
+		return matched[i].QueryID < matched[j].QueryID
+	})
+	return matched
+}
@@ -0,0 +1,430 @@
+package lacework
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/lacework/go-sdk/api"
+)
+
+var suppressionCloudTypes = []string{
+	string(api.AwsSuppression),
+	string(api.AzureSuppression),
+	string(api.GcpSuppression),
+}
+
+func dataSourceLaceworkSuppressionMigration() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceLaceworkSuppressionMigrationRead,
+		Schema: map[string]*schema.Schema{
+			"cloud_types": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The list of cloud types to read legacy suppressions from",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(suppressionCloudTypes, false),
+				},
+			},
+			"policy_ids": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "A map of legacy recommendation IDs to policy IDs that overrides the automatic mapping",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"policy_exceptions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"recommendation_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cloud_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"policy_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"constraint": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"field_key": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"field_values": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"field_value_map": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"key": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"value": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"unmapped_suppressions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"recommendation_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cloud_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceLaceworkSuppressionMigrationRead(d *schema.ResourceData, meta interface{}) error {
+	var (
+		lacework   = meta.(*providerMeta).client
+		cloudTypes = castAttributeToStringSlice(d, "cloud_types")
+		overrides  = make(map[string]string)
+		migration  = suppressionMigration{
+			Exceptions: make([]migratedPolicyException, 0),
+			Unmapped:   make([]unmappedSuppression, 0),
+		}
+	)
+
+	if len(cloudTypes) == 0 {
+		cloudTypes = suppressionCloudTypes
+	}
+
+	for recID, policyID := range d.Get("policy_ids").(map[string]interface{}) {
+		overrides[recID] = policyID.(string)
+	}
+
+	log.Printf("[INFO] Listing policies to map legacy recommendation IDs.")
+	var policies policyReferencesResponse
+	err := lacework.RequestDecoder("GET", "v2/Policies", nil, &policies)
+	if err != nil {
+		return err
+	}
+
+	for _, cloudType := range cloudTypes {
+		log.Printf("[INFO] Listing legacy %s suppressions.", cloudType)
+		suppressions, err := listLegacySuppressions(lacework, cloudType)
+		if err != nil {
+			return err
+		}
+
+		m := convertSuppressionsToPolicyExceptions(cloudType, suppressions, policies.Data, overrides)
+		migration.Exceptions = append(migration.Exceptions, m.Exceptions...)
+		migration.Unmapped = append(migration.Unmapped, m.Unmapped...)
+	}
+
+	log.Printf("[INFO] Converted legacy suppressions. policy_exceptions=%d, unmapped=%d",
+		len(migration.Exceptions), len(migration.Unmapped))
+
+	d.SetId(strings.Join(cloudTypes, ","))
+	d.Set("policy_exceptions", flattenMigratedPolicyExceptions(migration.Exceptions))
+	d.Set("unmapped_suppressions", flattenUnmappedSuppressions(migration.Unmapped))
+
+	return nil
+}
+
+func listLegacySuppressions(lacework *api.Client, cloudType string) (map[string]api.SuppressionV2, error) {
+	switch cloudType {
+	case string(api.AwsSuppression):
+		return lacework.V2.Suppressions.Aws.List()
+	case string(api.AzureSuppression):
+		return lacework.V2.Suppressions.Azure.List()
+	case string(api.GcpSuppression):
+		return lacework.V2.Suppressions.Gcp.List()
+	default:
+		return nil, fmt.Errorf("unsupported suppression cloud type '%s'", cloudType)
+	}
+}
+
+// policyReferencesResponse decodes the policies with the reference to the
+// legacy recommendation ID they replace, which the api.Policy type drops
+type policyReferencesResponse struct {
+	Data []policyReference `json:"data"`
+}
+
+type policyReference struct {
+	api.Policy
+	ReferenceID string `json:"referenceId"`
+}
+
+type suppressionMigration struct {
+	Exceptions []migratedPolicyException
+	Unmapped   []unmappedSuppression
+}
+
+type migratedPolicyException struct {
+	RecommendationID string
+	CloudType        string
+	PolicyID         string
+	Exception        api.PolicyException
+}
+
+type unmappedSuppression struct {
+	RecommendationID string
+	CloudType        string
+	Reason           string
+}
+
+// legacySuppressionWildcards are the values that legacy suppressions used
+// to match every account, region, etc. policy exceptions use '*' instead
+var legacySuppressionWildcards = []string{
+	"ALL_ACCOUNTS", "ALL_REGIONS", "ALL_ORGANIZATIONS", "ALL_PROJECTS",
+	"ALL_TENANTS", "ALL_SUBSCRIPTIONS", "ALL_RESOURCE_GROUPS",
+}
+
+// convertSuppressionsToPolicyExceptions turns every enabled legacy suppression condition
+// into a policy exception, conditions that contain a field the policy doesn't support
+// as a constraint are reported as unmapped since dropping the field would widen the exception
+func convertSuppressionsToPolicyExceptions(
+	cloudType string,
+	suppressions map[string]api.SuppressionV2,
+	policies []policyReference,
+	overrides map[string]string,
+) suppressionMigration {
+	var (
+		migration = suppressionMigration{
+			Exceptions: make([]migratedPolicyException, 0),
+			Unmapped:   make([]unmappedSuppression, 0),
+		}
+		policiesByID  = make(map[string]policyReference)
+		policiesByRef = make(map[string]policyReference)
+		recIDs        = make([]string, 0, len(suppressions))
+	)
+
+	for _, p := range policies {
+		policiesByID[p.PolicyID] = p
+		if p.ReferenceID != "" {
+			policiesByRef[p.ReferenceID] = p
+		}
+	}
+
+	for recID := range suppressions {
+		recIDs = append(recIDs, recID)
+	}
+	sort.Strings(recIDs)
+
+	for _, recID := range recIDs {
+		suppression := suppressions[recID]
+		if !suppression.Enabled || len(suppression.SuppressionConditions) == 0 {
+			continue
+		}
+
+		policy, found := policiesByRef[recID]
+		if policyID, ok := overrides[recID]; ok {
+			policy, found = policiesByID[policyID]
+			if !found {
+				policy, found = policyReference{Policy: api.Policy{PolicyID: policyID}}, true
+			}
+		}
+		if !found {
+			migration.Unmapped = append(migration.Unmapped, unmappedSuppression{
+				RecommendationID: recID,
+				CloudType:        cloudType,
+				Reason:           "no policy found for the legacy recommendation ID",
+			})
+			continue
+		}
+
+		for _, condition := range suppression.SuppressionConditions {
+			constraints := suppressionConditionToConstraints(cloudType, condition)
+			if unsupported := unsupportedConstraintKeys(policy.Policy, constraints); len(unsupported) > 0 {
+				migration.Unmapped = append(migration.Unmapped, unmappedSuppression{
+					RecommendationID: recID,
+					CloudType:        cloudType,
+					Reason: fmt.Sprintf("policy '%s' does not support the constraints: %s",
+						policy.PolicyID, strings.Join(unsupported, ", ")),
+				})
+				continue
+			}
+
+			description := condition.Comment
+			if description == "" {
+				description = fmt.Sprintf("Migrated from legacy suppression of recommendation %s", recID)
+			}
+
+			migration.Exceptions = append(migration.Exceptions, migratedPolicyException{
+				RecommendationID: recID,
+				CloudType:        cloudType,
+				PolicyID:         policy.PolicyID,
+				Exception: api.PolicyException{
+					Description: description,
+					Constraints: constraints,
+				},
+			})
+		}
+	}
+
+	return migration
+}
+
+func suppressionConditionToConstraints(cloudType string, c api.SuppressionConditions) []api.PolicyExceptionConstraint {
+	constraints := make([]api.PolicyExceptionConstraint, 0)
+
+	addValues := func(key string, values []string) {
+		if len(values) == 0 {
+			return
+		}
+		fieldValues := make([]any, len(values))
+		for i, v := range values {
+			if ContainsStr(legacySuppressionWildcards, v) {
+				v = "*"
+			}
+			fieldValues[i] = v
+		}
+		constraints = append(constraints, api.PolicyExceptionConstraint{FieldKey: key, FieldValues: fieldValues})
+	}
+
+	addMaps := func(key string, maps []map[string]string) {
+		if len(maps) == 0 {
+			return
+		}
+		fieldValues := make([]any, 0)
+		for _, m := range maps {
+			keys := make([]string, 0, len(m))
+			for k := range m {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			for _, k := range keys {
+				fieldValues = append(fieldValues, map[string]any{"key": k, "value": m[k]})
+			}
+		}
+		constraints = append(constraints, api.PolicyExceptionConstraint{FieldKey: key, FieldValues: fieldValues})
+	}
+
+	switch cloudType {
+	case string(api.AwsSuppression):
+		addValues("accountIds", c.AccountIds)
+		addValues("regionNames", c.RegionNames)
+		addValues("resourceNames", c.ResourceNames)
+		addMaps("resourceTags", c.ResourceTags)
+	case string(api.AzureSuppression):
+		addValues("tenantIds", c.TenantIds)
+		addValues("subscriptionIds", c.SubscriptionIds)
+		addValues("regionNames", c.RegionNames)
+		addValues("resourceGroupNames", c.ResourceGroupNames)
+		addValues("resourceNames", c.ResourceNames)
+		addMaps("resourceTags", c.ResourceTags)
+	case string(api.GcpSuppression):
+		addValues("organizations", c.OrganizationIds)
+		addValues("projects", c.ProjectIds)
+		addValues("regionNames", c.RegionNames)
+		addValues("resourceNames", c.ResourceNames)
+		addMaps("resourceLabel", c.ResourceLabels)
+	}
+
+	return constraints
+}
+
+// unsupportedConstraintKeys returns the constraint keys that are not part of the exception
+// configuration of the provided policy, when the policy has no exception configuration
+// (e.g. a policy ID provided by the user) all constraints are considered supported
+func unsupportedConstraintKeys(policy api.Policy, constraints []api.PolicyExceptionConstraint) []string {
+	fields, ok := policy.ExceptionConfiguration["constraintFields"]
+	if !ok {
+		return nil
+	}
+
+	supported := make([]string, len(fields))
+	for i, field := range fields {
+		supported[i] = field.FieldKey
+	}
+
+	unsupported := make([]string, 0)
+	for _, constraint := range constraints {
+		if !ContainsStr(supported, constraint.FieldKey) {
+			unsupported = append(unsupported, constraint.FieldKey)
+		}
+	}
+	return unsupported
+}
+
+func flattenMigratedPolicyExceptions(exceptions []migratedPolicyException) []map[string]interface{} {
+	out := make([]map[string]interface{}, 0, len(exceptions))
+	for _, e := range exceptions {
+		constraints := make([]map[string]interface{}, 0, len(e.Exception.Constraints))
+		for _, c := range e.Exception.Constraints {
+			var (
+				values    = make([]string, 0)
+				valueMaps = make([]map[string]interface{}, 0)
+			)
+			for _, v := range c.FieldValues {
+				switch value := v.(type) {
+				case string:
+					values = append(values, value)
+				case map[string]any:
+					valueMaps = append(valueMaps, value)
+				}
+			}
+			constraints = append(constraints, map[string]interface{}{
+				"field_key":       c.FieldKey,
+				"field_values":    values,
+				"field_value_map": valueMaps,
+			})
+		}
+
+		out = append(out, map[string]interface{}{
+			"recommendation_id": e.RecommendationID,
+			"cloud_type":        e.CloudType,
+			"policy_id":         e.PolicyID,
+			"description":       e.Exception.Description,
+			"constraint":        constraints,
+		})
+	}
+	return out
+}
+
+func flattenUnmappedSuppressions(unmapped []unmappedSuppression) []map[string]interface{} {
+	out := make([]map[string]interface{}, 0, len(unmapped))
+	for _, u := range unmapped {
+		out = append(out, map[string]interface{}{
+			"recommendation_id": u.RecommendationID,
+			"cloud_type":        u.CloudType,
+			"reason":            u.Reason,
+		})
+	}
+	return out
+}
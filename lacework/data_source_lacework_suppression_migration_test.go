@@ -0,0 +1,110 @@
+package lacework
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lacework/go-sdk/api"
+)
+
+func TestConvertSuppressionsToPolicyExceptions(t *testing.T) {
+	var (
+		policies = []policyReference{
+			{
+				Policy: api.Policy{
+					PolicyID: "lacework-global-1",
+					ExceptionConfiguration: map[string][]api.PolicyExceptionConfigurationConstraints{
+						"constraintFields": {
+							{FieldKey: "accountIds"},
+							{FieldKey: "regionNames"},
+							{FieldKey: "resourceTags"},
+						},
+					},
+				},
+				ReferenceID: "AWS_CIS_1_1",
+			},
+			{
+				Policy: api.Policy{
+					PolicyID: "lacework-global-2",
+					ExceptionConfiguration: map[string][]api.PolicyExceptionConfigurationConstraints{
+						"constraintFields": {{FieldKey: "accountIds"}},
+					},
+				},
+				ReferenceID: "AWS_CIS_1_2",
+			},
+		}
+		suppressions = map[string]api.SuppressionV2{
+			"AWS_CIS_1_1": {
+				Enabled: true,
+				SuppressionConditions: []api.SuppressionConditions{
+					{
+						AccountIds:   []string{"ALL_ACCOUNTS"},
+						RegionNames:  []string{"us-west-2"},
+						ResourceTags: []map[string]string{{"env": "dev"}},
+						Comment:      "dev accounts are out of scope",
+					},
+				},
+			},
+			"AWS_CIS_1_2": {
+				Enabled: true,
+				SuppressionConditions: []api.SuppressionConditions{
+					{AccountIds: []string{"123456789012"}, ResourceNames: []string{"bucket"}},
+				},
+			},
+			"AWS_CIS_1_3": {
+				Enabled:               true,
+				SuppressionConditions: []api.SuppressionConditions{{AccountIds: []string{"123456789012"}}},
+			},
+			"AWS_CIS_1_4": {
+				Enabled:               false,
+				SuppressionConditions: []api.SuppressionConditions{{AccountIds: []string{"123456789012"}}},
+			},
+		}
+		migration = convertSuppressionsToPolicyExceptions("aws", suppressions, policies, map[string]string{})
+	)
+
+	if assert.Len(t, migration.Exceptions, 1) {
+		exception := migration.Exceptions[0]
+		assert.Equal(t, "AWS_CIS_1_1", exception.RecommendationID)
+		assert.Equal(t, "lacework-global-1", exception.PolicyID)
+		assert.Equal(t, "dev accounts are out of scope", exception.Exception.Description)
+		assert.Equal(t, []api.PolicyExceptionConstraint{
+			{FieldKey: "accountIds", FieldValues: []any{"*"}},
+			{FieldKey: "regionNames", FieldValues: []any{"us-west-2"}},
+			{FieldKey: "resourceTags", FieldValues: []any{map[string]any{"key": "env", "value": "dev"}}},
+		}, exception.Exception.Constraints)
+	}
+
+	if assert.Len(t, migration.Unmapped, 2) {
+		assert.Equal(t, "AWS_CIS_1_2", migration.Unmapped[0].RecommendationID)
+		assert.Contains(t, migration.Unmapped[0].Reason, "resourceNames")
+		assert.Equal(t, "AWS_CIS_1_3", migration.Unmapped[1].RecommendationID)
+	}
+}
+
+func TestConvertSuppressionsToPolicyExceptionsWithOverrides(t *testing.T) {
+	var (
+		suppressions = map[string]api.SuppressionV2{
+			"GCP_CIS_1_1": {
+				Enabled: true,
+				SuppressionConditions: []api.SuppressionConditions{
+					{OrganizationIds: []string{"ALL_ORGANIZATIONS"}, ProjectIds: []string{"my-project"}},
+				},
+			},
+		}
+		migration = convertSuppressionsToPolicyExceptions("gcp", suppressions, nil,
+			map[string]string{"GCP_CIS_1_1": "custom-policy-1"})
+	)
+
+	assert.Empty(t, migration.Unmapped)
+	if assert.Len(t, migration.Exceptions, 1) {
+		assert.Equal(t, "custom-policy-1", migration.Exceptions[0].PolicyID)
+		assert.Equal(t, "Migrated from legacy suppression of recommendation GCP_CIS_1_1",
+			migration.Exceptions[0].Exception.Description)
+		assert.Equal(t, []api.PolicyExceptionConstraint{
+			{FieldKey: "organizations", FieldValues: []any{"*"}},
+			{FieldKey: "projects", FieldValues: []any{"my-project"}},
+		}, migration.Exceptions[0].Exception.Constraints)
+	}
+}
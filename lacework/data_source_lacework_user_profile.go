@@ -2,8 +2,6 @@ package lacework
 
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-
-	"github.com/lacework/go-sdk/api"
 )
 
 func dataSourceLaceworkUserProfile() *schema.Resource {
@@ -63,7 +61,7 @@ func dataSourceLaceworkUserProfile() *schema.Resource {
 }
 
 func dataSourceLaceworkUserProfileRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	response, err := lacework.V2.UserProfile.Get()
 	if err != nil {
@@ -0,0 +1,305 @@
+package lacework
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/lacework/go-sdk/api"
+)
+
+const (
+	vulnerabilityScanStatusSuccess = "Success"
+
+	// the vulnerability search API accepts time windows of up to 7 days, scans are
+	// searched one window at a time up to the retention of the assessments
+	vulnerabilityScanSearchWindow    = 7 * 24 * time.Hour
+	vulnerabilityScanSearchRetention = 90 * 24 * time.Hour
+)
+
+// dataSourceLaceworkVulnerabilityScanStatus reads on-demand container vulnerability scans only,
+// host scans of package manifests return their results synchronously without a request ID, and
+// the host vulnerabilities API only searches the assessments of hosts with an agent
+func dataSourceLaceworkVulnerabilityScanStatus() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceLaceworkVulnerabilityScanStatusRead,
+		Schema: map[string]*schema.Schema{
+			"request_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "The request ID returned by an on-demand container vulnerability scan, host scans are not supported",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the scan, for example Scanning, Success or Failed",
+			},
+			"eval_guid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The evaluation GUID of the vulnerability assessment",
+			},
+			"completed": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the scan completed successfully and the results are available",
+			},
+			"image_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"image_digest": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"highest_severity": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"highest_fixable_severity": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"total_vulnerabilities": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"fixable_vulnerabilities": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"vulnerability_counts": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The number of vulnerabilities found in the image by severity, and how many of them have a fix available",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"critical": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"critical_fixable": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"high": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"high_fixable": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"medium": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"medium_fixable": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"low": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"low_fixable": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"info": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"info_fixable": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"total": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"total_fixable": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceLaceworkVulnerabilityScanStatusRead(d *schema.ResourceData, meta interface{}) error {
+	var (
+		lacework  = meta.(*providerMeta).client
+		requestID = d.Get("request_id").(string)
+	)
+
+	log.Printf("[INFO] Reading container vulnerability scan status. request_id=%s", requestID)
+	response, err := lacework.V2.Vulnerabilities.Containers.ScanStatus(requestID)
+	if err != nil {
+		return err
+	}
+
+	status := response.CheckStatus()
+	log.Printf("[INFO] Container vulnerability scan status. request_id=%s, status=%s, eval_guid=%s",
+		requestID, status, response.Data.EvalGuid)
+
+	d.SetId(requestID)
+	d.Set("status", status)
+	d.Set("eval_guid", response.Data.EvalGuid)
+
+	// the results of the scan are only available once it completes
+	completed := strings.EqualFold(status, vulnerabilityScanStatusSuccess) && response.Data.EvalGuid != ""
+	d.Set("completed", completed)
+	if !completed {
+		return nil
+	}
+
+	log.Printf("[INFO] Searching container vulnerabilities. eval_guid=%s", response.Data.EvalGuid)
+	assessment, err := searchContainerAssessment(
+		lacework.V2.Vulnerabilities.Containers.SearchAllPages, response.Data.EvalGuid, time.Now().UTC(),
+	)
+	if err != nil {
+		return err
+	}
+
+	d.Set("image_id", assessment.Data[0].ImageID)
+	d.Set("image_digest", assessment.Data[0].EvalCtx.ImageInfo.Digest)
+	highest, highestFixable := highestContainerSeverities(assessment.Data)
+	d.Set("highest_severity", highest.String())
+	d.Set("highest_fixable_severity", highestFixable.String())
+
+	// the totals are derived from the counts so they always agree, only vulnerable packages are counted
+	counts := countContainerVulnerabilities(assessment.Data)
+	d.Set("total_vulnerabilities", int(counts.Total))
+	d.Set("fixable_vulnerabilities", int(counts.TotalFixable))
+	d.Set("vulnerability_counts", flattenVulnerabilityCounts(counts))
+
+	return nil
+}
+
+// searchContainerAssessment searches the vulnerabilities of the container assessment with the
+// provided evaluation GUID. The scan status API does not return when the image was evaluated,
+// so the search walks back from now one window at a time until the assessment is found, a
+// successful scan without any results is an error rather than an image without vulnerabilities
+func searchContainerAssessment(
+	search func(api.SearchFilter) (api.VulnerabilitiesContainersResponse, error),
+	evalGuid string, now time.Time,
+) (api.VulnerabilitiesContainersResponse, error) {
+	for end := now; now.Sub(end) < vulnerabilityScanSearchRetention; end = end.Add(-vulnerabilityScanSearchWindow) {
+		var (
+			endTime   = end
+			startTime = end.Add(-vulnerabilityScanSearchWindow)
+		)
+		log.Printf("[DEBUG] Searching container vulnerabilities. eval_guid=%s, start_time=%s, end_time=%s",
+			evalGuid, startTime.Format(time.RFC3339), endTime.Format(time.RFC3339))
+		assessment, err := search(api.SearchFilter{
+			TimeFilter: &api.TimeFilter{
+				StartTime: &startTime,
+				EndTime:   &endTime,
+			},
+			Filters: []api.Filter{{
+				Expression: "eq",
+				Field:      "evalGuid",
+				Value:      evalGuid,
+			}},
+		})
+		if err != nil {
+			return assessment, err
+		}
+		if len(assessment.Data) > 0 {
+			return assessment, nil
+		}
+	}
+
+	return api.VulnerabilitiesContainersResponse{}, fmt.Errorf(
+		"The scan with eval_guid %s succeeded but its results were not found in the last %d days",
+		evalGuid, int(vulnerabilityScanSearchRetention.Hours()/24),
+	)
+}
+
+// highestContainerSeverities returns the highest severity of the vulnerabilities of a container
+// assessment, and of the ones that have a fix available, only vulnerable packages are counted
+func highestContainerSeverities(vulns []api.VulnerabilityContainer) (severity, severity) {
+	var severities, fixableSeverities []string
+	for _, vuln := range vulns {
+		if vuln.Status != "VULNERABLE" {
+			continue
+		}
+		severities = append(severities, vuln.Severity)
+		if vuln.FixInfo.FixAvailable == 1 {
+			fixableSeverities = append(fixableSeverities, vuln.Severity)
+		}
+	}
+	return highestSeverity(severities...), highestSeverity(fixableSeverities...)
+}
+
+// countContainerVulnerabilities counts the vulnerabilities of a container assessment by severity,
+// like the counts of host assessments, only the packages that are vulnerable are counted
+func countContainerVulnerabilities(vulns []api.VulnerabilityContainer) api.HostVulnCounts {
+	counts := api.HostVulnCounts{}
+	for _, vuln := range vulns {
+		if vuln.Status != "VULNERABLE" {
+			continue
+		}
+
+		fixable := vuln.FixInfo.FixAvailable == 1
+		counts.Total++
+		if fixable {
+			counts.TotalFixable++
+		}
+
+		// unknown severities are counted as info, like the host assessments of the Lacework CLI
+		switch newSeverity(vuln.Severity) {
+		case severityCritical:
+			counts.Critical++
+			if fixable {
+				counts.CritFixable++
+			}
+		case severityHigh:
+			counts.High++
+			if fixable {
+				counts.HighFixable++
+			}
+		case severityMedium:
+			counts.Medium++
+			if fixable {
+				counts.MedFixable++
+			}
+		case severityLow:
+			counts.Low++
+			if fixable {
+				counts.LowFixable++
+			}
+		default:
+			counts.Info++
+			if fixable {
+				counts.InfoFixable++
+			}
+		}
+	}
+	return counts
+}
+
+func flattenVulnerabilityCounts(counts api.HostVulnCounts) []map[string]interface{} {
+	return []map[string]interface{}{{
+		"critical":         int(counts.Critical),
+		"critical_fixable": int(counts.CritFixable),
+		"high":             int(counts.High),
+		"high_fixable":     int(counts.HighFixable),
+		"medium":           int(counts.Medium),
+		"medium_fixable":   int(counts.MedFixable),
+		"low":              int(counts.Low),
+		"low_fixable":      int(counts.LowFixable),
+		"info":             int(counts.Info),
+		"info_fixable":     int(counts.InfoFixable),
+		"total":            int(counts.Total),
+		"total_fixable":    int(counts.TotalFixable),
+	}}
+}
@@ -0,0 +1,122 @@
+package lacework
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lacework/go-sdk/api"
+)
+
+func containerVulnerability(severity, status string, fixAvailable int) api.VulnerabilityContainer {
+	v := api.VulnerabilityContainer{Severity: severity, Status: status}
+	v.FixInfo.FixAvailable = fixAvailable
+	return v
+}
+
+func TestCountContainerVulnerabilities(t *testing.T) {
+	counts := countContainerVulnerabilities([]api.VulnerabilityContainer{
+		containerVulnerability("Critical", "VULNERABLE", 1),
+		containerVulnerability("critical", "VULNERABLE", 0),
+		containerVulnerability("High", "VULNERABLE", 1),
+		containerVulnerability("Medium", "VULNERABLE", 0),
+		containerVulnerability("Low", "VULNERABLE", 1),
+		containerVulnerability("Info", "VULNERABLE", 0),
+		containerVulnerability("Negligible", "VULNERABLE", 1),
+		containerVulnerability("Critical", "GOOD", 1),
+		containerVulnerability("Low", "GOOD", 1),
+	})
+
+	assert.Equal(t, api.HostVulnCounts{
+		Critical:     2,
+		CritFixable:  1,
+		High:         1,
+		HighFixable:  1,
+		Medium:       1,
+		Low:          1,
+		LowFixable:   1,
+		Info:         2,
+		InfoFixable:  1,
+		Total:        7,
+		TotalFixable: 4,
+	}, counts)
+
+	flattened := flattenVulnerabilityCounts(counts)
+	if assert.Len(t, flattened, 1) {
+		assert.Equal(t, 2, flattened[0]["critical"])
+		assert.Equal(t, 1, flattened[0]["critical_fixable"])
+		assert.Equal(t, 4, flattened[0]["total_fixable"])
+	}
+
+	// severities that are not valid, including empty ones, are counted as info
+	assert.Equal(t, api.HostVulnCounts{Info: 2, InfoFixable: 1, Total: 2, TotalFixable: 1},
+		countContainerVulnerabilities([]api.VulnerabilityContainer{
+			containerVulnerability("", "VULNERABLE", 1),
+			containerVulnerability("UNKNOWN", "VULNERABLE", 0),
+		}))
+
+	// images without vulnerabilities have zero counts
+	empty := flattenVulnerabilityCounts(countContainerVulnerabilities(nil))
+	if assert.Len(t, empty, 1) {
+		assert.Equal(t, 0, empty[0]["total"])
+		assert.Equal(t, 0, empty[0]["total_fixable"])
+	}
+}
+
+func TestHighestContainerSeverities(t *testing.T) {
+	highest, highestFixable := highestContainerSeverities([]api.VulnerabilityContainer{
+		containerVulnerability("Critical", "GOOD", 1),
+		containerVulnerability("Medium", "VULNERABLE", 0),
+		containerVulnerability("low", "VULNERABLE", 1),
+	})
+	assert.Equal(t, severityMedium, highest)
+	assert.Equal(t, severityLow, highestFixable)
+
+	highest, highestFixable = highestContainerSeverities(nil)
+	assert.Equal(t, severityUnknown, highest)
+	assert.Equal(t, severityUnknown, highestFixable)
+}
+
+func TestSearchContainerAssessment(t *testing.T) {
+	var (
+		now     = time.Date(2024, 3, 20, 12, 0, 0, 0, time.UTC)
+		scanned = now.AddDate(0, 0, -10)
+		windows []api.TimeFilter
+	)
+	search := func(filter api.SearchFilter) (api.VulnerabilitiesContainersResponse, error) {
+		windows = append(windows, *filter.TimeFilter)
+		assert.Equal(t, "abc123", filter.Filters[0].Value)
+
+		response := api.VulnerabilitiesContainersResponse{}
+		if !scanned.Before(*filter.TimeFilter.StartTime) && scanned.Before(*filter.TimeFilter.EndTime) {
+			response.Data = []api.VulnerabilityContainer{{ImageID: "sha256:image"}}
+		}
+		return response, nil
+	}
+
+	assessment, err := searchContainerAssessment(search, "abc123", now)
+	if assert.Nil(t, err) && assert.Len(t, assessment.Data, 1) {
+		assert.Equal(t, "sha256:image", assessment.Data[0].ImageID)
+	}
+	if assert.Len(t, windows, 2) {
+		assert.Equal(t, now, *windows[0].EndTime)
+		assert.Equal(t, now.Add(-vulnerabilityScanSearchWindow), *windows[0].StartTime)
+		assert.Equal(t, *windows[0].StartTime, *windows[1].EndTime)
+	}
+}
+
+func TestSearchContainerAssessmentNotFound(t *testing.T) {
+	calls := 0
+	search := func(filter api.SearchFilter) (api.VulnerabilitiesContainersResponse, error) {
+		calls++
+		return api.VulnerabilitiesContainersResponse{}, nil
+	}
+
+	_, err := searchContainerAssessment(search, "abc123", time.Now().UTC())
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "abc123")
+		assert.Contains(t, err.Error(), "90 days")
+	}
+	assert.Equal(t, 13, calls)
+}
@@ -0,0 +1,144 @@
+package lacework
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The review information of exception resources is stored as trailers at the
+// end of the exception description, the same format that git uses for commit
+// messages, so that it is readable from the Lacework Console:
+//
+//	Exclude development accounts
+//
+//	Approved-By: security@example.com
+//	Ticket-Ref: SEC-1234
+const (
+	exceptionApprovedByTrailer = "Approved-By"
+	exceptionTicketRefTrailer  = "Ticket-Ref"
+)
+
+func exceptionApprovedBySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The person or team that approved the exception",
+		StateFunc: func(val interface{}) string {
+			return strings.TrimSpace(val.(string))
+		},
+	}
+}
+
+func exceptionTicketRefSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The reference of the ticket that tracks the approval of the exception",
+		StateFunc: func(val interface{}) string {
+			return strings.TrimSpace(val.(string))
+		},
+	}
+}
+
+// getExceptionDescription returns the description of an exception resource
+// with the review information appended as trailers
+func getExceptionDescription(d *schema.ResourceData) string {
+	return encodeExceptionDescription(
+		d.Get("description").(string),
+		d.Get("approved_by").(string),
+		d.Get("ticket_ref").(string),
+	)
+}
+
+// setExceptionDescription sets the description and the review
+// information of an exception resource from the stored description
+func setExceptionDescription(d *schema.ResourceData, stored string) {
+	description, approvedBy, ticketRef := decodeExceptionDescription(stored)
+	d.Set("description", description)
+	d.Set("approved_by", approvedBy)
+	d.Set("ticket_ref", ticketRef)
+}
+
+func encodeExceptionDescription(description, approvedBy, ticketRef string) string {
+	// avoid duplicating trailers that were added to the description manually
+	description, _, _ = decodeExceptionDescription(description)
+
+	trailers := make([]string, 0, 2)
+	if approvedBy = strings.TrimSpace(approvedBy); approvedBy != "" {
+		trailers = append(trailers, fmt.Sprintf("%s: %s", exceptionApprovedByTrailer, approvedBy))
+	}
+	if ticketRef = strings.TrimSpace(ticketRef); ticketRef != "" {
+		trailers = append(trailers, fmt.Sprintf("%s: %s", exceptionTicketRefTrailer, ticketRef))
+	}
+
+	if len(trailers) == 0 {
+		return description
+	}
+	if description == "" {
+		return strings.Join(trailers, "\n")
+	}
+	return fmt.Sprintf("%s\n\n%s", description, strings.Join(trailers, "\n"))
+}
+
+func decodeExceptionDescription(stored string) (description, approvedBy, ticketRef string) {
+	lines := strings.Split(strings.TrimRight(stored, "\n"), "\n")
+
+	// read trailers from the bottom of the description
+	for len(lines) > 0 {
+		line := lines[len(lines)-1]
+		if value, ok := cutTrailer(line, exceptionApprovedByTrailer); ok && approvedBy == "" {
+			approvedBy = value
+		} else if value, ok := cutTrailer(line, exceptionTicketRefTrailer); ok && ticketRef == "" {
+			ticketRef = value
+		} else {
+			break
+		}
+		lines = lines[:len(lines)-1]
+	}
+
+	if approvedBy == "" && ticketRef == "" {
+		return stored, "", ""
+	}
+
+	description = strings.TrimRight(strings.Join(lines, "\n"), "\n")
+	return
+}
+
+func cutTrailer(line, trailer string) (string, bool) {
+	value, found := strings.CutPrefix(line, trailer+":")
+	if !found {
+		return "", false
+	}
+	return strings.TrimSpace(value), true
+}
+
+// validateExceptionReview is a CustomizeDiff function that enforces, at plan time, that
+// exception resources have review information when the provider requires it
+func validateExceptionReview(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	m, ok := meta.(*providerMeta)
+	if !ok || !m.requireExceptionApproval {
+		return nil
+	}
+
+	missing := make([]string, 0, 2)
+	for _, attr := range []string{"approved_by", "ticket_ref"} {
+		// values that are not known until apply can't be validated
+		if !diff.NewValueKnown(attr) {
+			continue
+		}
+		if strings.TrimSpace(diff.Get(attr).(string)) == "" {
+			missing = append(missing, attr)
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf(
+			"the provider requires exceptions to be approved, missing required arguments: %s",
+			strings.Join(missing, ", "),
+		)
+	}
+	return nil
+}
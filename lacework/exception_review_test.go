@@ -0,0 +1,54 @@
+package lacework
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeExceptionDescription(t *testing.T) {
+	assert.Equal(t, "Exclude dev", encodeExceptionDescription("Exclude dev", "", ""))
+	assert.Equal(t,
+		"Exclude dev\n\nApproved-By: security@example.com\nTicket-Ref: SEC-1234",
+		encodeExceptionDescription("Exclude dev", " security@example.com ", "SEC-1234"),
+	)
+	assert.Equal(t, "Ticket-Ref: SEC-1234", encodeExceptionDescription("", "", "SEC-1234"))
+	assert.Equal(t,
+		"Exclude dev\n\nApproved-By: bob",
+		encodeExceptionDescription("Exclude dev\n\nApproved-By: alice", "bob", ""),
+		"existing trailers should be replaced",
+	)
+}
+
+func TestDecodeExceptionDescription(t *testing.T) {
+	cases := []struct {
+		stored      string
+		description string
+		approvedBy  string
+		ticketRef   string
+	}{
+		{"Exclude dev", "Exclude dev", "", ""},
+		{"", "", "", ""},
+		{"Exclude dev\n\nApproved-By: security@example.com\nTicket-Ref: SEC-1234",
+			"Exclude dev", "security@example.com", "SEC-1234"},
+		{"Exclude dev\n\nTicket-Ref: SEC-1234\n", "Exclude dev", "", "SEC-1234"},
+		{"Approved-By: alice", "", "alice", ""},
+		{"Approved-By: alice\nin the middle", "Approved-By: alice\nin the middle", "", ""},
+	}
+
+	for _, c := range cases {
+		description, approvedBy, ticketRef := decodeExceptionDescription(c.stored)
+		assert.Equal(t, c.description, description, c.stored)
+		assert.Equal(t, c.approvedBy, approvedBy, c.stored)
+		assert.Equal(t, c.ticketRef, ticketRef, c.stored)
+	}
+}
+
+func TestExceptionDescriptionRoundTrip(t *testing.T) {
+	description, approvedBy, ticketRef := decodeExceptionDescription(
+		encodeExceptionDescription("Multi\nline\ndescription", "alice", "SEC-1"),
+	)
+	assert.Equal(t, "Multi\nline\ndescription", description)
+	assert.Equal(t, "alice", approvedBy)
+	assert.Equal(t, "SEC-1", ticketRef)
+}
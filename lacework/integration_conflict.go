@@ -0,0 +1,227 @@
+package lacework
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/lacework/go-sdk/api"
+)
+
+// the values of the use_existing_on_conflict provider setting, integrations are
+// created without looking up existing ones when it is not set
+const (
+	integrationConflictAdopt = "adopt"
+	integrationConflictFail  = "fail"
+)
+
+var integrationConflictModes = []string{integrationConflictAdopt, integrationConflictFail}
+
+// existingIntegration is an integration with the same name and type as the
+// integration that is about to be created
+type existingIntegration struct {
+	guid            string
+	integrationType string
+}
+
+// existingIntegrationLookup returns the integrations that have the same name and
+// type as the integration that is about to be created
+type existingIntegrationLookup func(lacework *api.Client, d *schema.ResourceData) ([]existingIntegration, error)
+
+// integrationConflictLookups are the integration and alert channel resources that
+// support the use_existing_on_conflict provider setting
+var integrationConflictLookups = map[string]existingIntegrationLookup{
+	"lacework_alert_channel":                          alertChannelLookup(""),
+	"lacework_alert_channel_aws_cloudwatch":           alertChannelLookup(api.CloudwatchEbAlertChannelType.String()),
+	"lacework_alert_channel_aws_s3":                   alertChannelLookup(api.AwsS3AlertChannelType.String()),
+	"lacework_alert_channel_cisco_webex":              alertChannelLookup(api.CiscoSparkWebhookAlertChannelType.String()),
+	"lacework_alert_channel_datadog":                  alertChannelLookup(api.DatadogAlertChannelType.String()),
+	"lacework_alert_channel_email":                    alertChannelLookup(api.EmailUserAlertChannelType.String()),
+	"lacework_alert_channel_gcp_pub_sub":              alertChannelLookup(api.GcpPubSubAlertChannelType.String()),
+	"lacework_alert_channel_jira_cloud":               jiraAlertChannelLookup(api.JiraCloudAlertType),
+	"lacework_alert_channel_jira_server":              jiraAlertChannelLookup(api.JiraServerAlertType),
+	"lacework_alert_channel_newrelic":                 alertChannelLookup(api.NewRelicInsightsAlertChannelType.String()),
+	"lacework_alert_channel_pagerduty":                alertChannelLookup(api.PagerDutyApiAlertChannelType.String()),
+	"lacework_alert_channel_qradar":                   alertChannelLookup(api.IbmQRadarAlertChannelType.String()),
+	"lacework_alert_channel_microsoft_teams":          alertChannelLookup(api.MicrosoftTeamsAlertChannelType.String()),
+	"lacework_alert_channel_slack":                    alertChannelLookup(api.SlackChannelAlertChannelType.String()),
+	"lacework_alert_channel_splunk":                   alertChannelLookup(api.SplunkHecAlertChannelType.String()),
+	"lacework_alert_channel_service_now":              alertChannelLookup(api.ServiceNowRestAlertChannelType.String()),
+	"lacework_alert_channel_victorops":                alertChannelLookup(api.VictorOpsAlertChannelType.String()),
+	"lacework_alert_channel_webhook":                  alertChannelLookup(api.WebhookAlertChannelType.String()),
+	"lacework_cloud_account":                          cloudAccountLookup(""),
+	"lacework_integration_aws_agentless_scanning":     cloudAccountLookup(api.AwsSidekickCloudAccount.String()),
+	"lacework_integration_aws_org_agentless_scanning": cloudAccountLookup(api.AwsSidekickOrgCloudAccount.String()),
+	"lacework_integration_aws_cfg":                    cloudAccountLookup(api.AwsCfgCloudAccount.String()),
+	"lacework_integration_aws_ct":                     cloudAccountLookup(api.AwsCtSqsCloudAccount.String()),
+	"lacework_integration_aws_eks_audit_log":          cloudAccountLookup(api.AwsEksAuditCloudAccount.String()),
+	"lacework_integration_aws_govcloud_cfg":           cloudAccountLookup(api.AwsUsGovCfgCloudAccount.String()),
+	"lacework_integration_aws_govcloud_ct":            cloudAccountLookup(api.AwsUsGovCtSqsCloudAccount.String()),
+	"lacework_integration_azure_cfg":                  cloudAccountLookup(api.AzureCfgCloudAccount.String()),
+	"lacework_integration_azure_al":                   cloudAccountLookup(api.AzureAlSeqCloudAccount.String()),
+	"lacework_integration_docker_hub":                 containerRegistryLookup(api.DockerhubContainerRegistry.String()),
+	"lacework_integration_docker_v2":                  containerRegistryLookup(api.DockerhubV2ContainerRegistry.String()),
+	"lacework_integration_ecr":                        containerRegistryLookup(api.AwsEcrContainerRegistry.String()),
+	"lacework_integration_gcp_cfg":                    cloudAccountLookup(api.GcpCfgCloudAccount.String()),
+	"lacework_integration_gcp_at":                     cloudAccountLookup(api.GcpAtSesCloudAccount.String()),
+	"lacework_integration_gcp_pub_sub_audit_log":      cloudAccountLookup(api.GcpAlPubSubCloudAccount.String()),
+	"lacework_integration_gcp_gke_audit_log":          cloudAccountLookup(api.GcpGkeAuditCloudAccount.String()),
+	"lacework_integration_gcp_agentless_scanning":     cloudAccountLookup(api.GcpSidekickCloudAccount.String()),
+	"lacework_integration_gar":                        containerRegistryLookup(api.GcpGarContainerRegistry.String()),
+	"lacework_integration_gcr":                        containerRegistryLookup(api.GcpGcrContainerRegistry.String()),
+	"lacework_integration_ghcr":                       containerRegistryLookup(api.GhcrContainerRegistry.String()),
+	"lacework_integration_inline_scanner":             containerRegistryLookup(api.InlineScannerContainerRegistry.String()),
+	"lacework_integration_oci_cfg":                    cloudAccountLookup(api.OciCfgCloudAccount.String()),
+	"lacework_integration_proxy_scanner":              containerRegistryLookup(api.ProxyScannerContainerRegistry.String()),
+}
+
+// useExistingOnConflict wraps the create function of the integration and alert channel
+// resources, when the provider is configured with use_existing_on_conflict and there is
+// an integration with the same name and type, it is either adopted instead of creating a
+// duplicate, or the creation fails
+func useExistingOnConflict(resources map[string]*schema.Resource) {
+	for name, lookup := range integrationConflictLookups {
+		resource, ok := resources[name]
+		if !ok {
+			continue
+		}
+		resource.Create = createOrUseExisting(name, resource, lookup)
+	}
+}
+
+func createOrUseExisting(name string, resource *schema.Resource, lookup existingIntegrationLookup) schema.CreateFunc {
+	create := resource.Create
+	return func(d *schema.ResourceData, meta interface{}) error {
+		m, ok := meta.(*providerMeta)
+		if !ok || m.integrationConflictMode == "" {
+			return create(d, meta)
+		}
+
+		integrationName := d.Get("name").(string)
+		log.Printf("[INFO] Looking up existing %s with name: %s\n", name, integrationName)
+		existing, err := lookup(m.client, d)
+		if err != nil {
+			return fmt.Errorf("Unable to look up existing %s with name '%s': %s", name, integrationName, err)
+		}
+
+		guids := make([]string, 0, len(existing))
+		for _, integration := range existing {
+			guids = append(guids, integration.guid)
+		}
+
+		switch {
+		case len(existing) == 0:
+			return create(d, meta)
+		case m.integrationConflictMode == integrationConflictFail:
+			return fmt.Errorf(
+				"Found existing %s with name '%s' and type '%s' (%s). "+
+					"Import it, rename the resource, or set use_existing_on_conflict to '%s' to use it.",
+				name, integrationName, existing[0].integrationType, strings.Join(guids, ", "),
+				integrationConflictAdopt,
+			)
+		case len(existing) == 1:
+			log.Printf("[INFO] Using existing %s with guid: %s\n", name, guids[0])
+			d.SetId(guids[0])
+
+			// apply the configuration to the existing integration
+			if resource.Update != nil {
+				return resource.Update(d, meta)
+			}
+			return resource.Read(d, meta)
+		default:
+			return fmt.Errorf(
+				"Found %d existing %s with name '%s' (%s), unable to choose which one to use. "+
+					"Remove the duplicates or rename the resource.",
+				len(existing), name, integrationName, strings.Join(guids, ", "),
+			)
+		}
+	}
+}
+
+// alertChannelLookup returns a lookup of alert channels of the provided type,
+// if no type is provided, it is read from the 'type' argument of the resource
+func alertChannelLookup(channelType string) existingIntegrationLookup {
+	return filteredAlertChannelLookup(channelType, nil)
+}
+
+// jiraAlertChannelLookup returns a lookup of Jira alert channels, Jira Cloud and Jira Server
+// alert channels have the same type and are told apart by the jiraType of their data
+func jiraAlertChannelLookup(jiraType string) existingIntegrationLookup {
+	return filteredAlertChannelLookup(api.JiraAlertChannelType.String(), func(channel api.AlertChannelRaw) bool {
+		data, ok := channel.Data.(map[string]interface{})
+		return ok && data["jiraType"] == jiraType
+	})
+}
+
+// filteredAlertChannelLookup returns a lookup of alert channels of the provided type that
+// match the filter, if no filter is provided, all the alert channels of the type match
+func filteredAlertChannelLookup(channelType string, filter func(api.AlertChannelRaw) bool) existingIntegrationLookup {
+	return func(lacework *api.Client, d *schema.ResourceData) ([]existingIntegration, error) {
+		response, err := lacework.V2.AlertChannels.List()
+		if err != nil {
+			return nil, err
+		}
+
+		lookupType := channelType
+		if lookupType == "" {
+			lookupType = d.Get("type").(string)
+		}
+
+		existing := make([]existingIntegration, 0)
+		for _, channel := range response.Data {
+			if channel.Type != lookupType || channel.Name != d.Get("name").(string) {
+				continue
+			}
+			if filter != nil && !filter(channel) {
+				continue
+			}
+			existing = append(existing, existingIntegration{guid: channel.IntgGuid, integrationType: channel.Type})
+		}
+		return existing, nil
+	}
+}
+
+// cloudAccountLookup returns a lookup of Cloud Account integrations of the provided type,
+// if no type is provided, it is read from the 'type' argument of the resource
+func cloudAccountLookup(cloudType string) existingIntegrationLookup {
+	return func(lacework *api.Client, d *schema.ResourceData) ([]existingIntegration, error) {
+		response, err := lacework.V2.CloudAccounts.List()
+		if err != nil {
+			return nil, err
+		}
+
+		lookupType := cloudType
+		if lookupType == "" {
+			lookupType = d.Get("type").(string)
+		}
+
+		existing := make([]existingIntegration, 0)
+		for _, cloudAccount := range response.Data {
+			if cloudAccount.Type == lookupType && cloudAccount.Name == d.Get("name").(string) {
+				existing = append(existing,
+					existingIntegration{guid: cloudAccount.IntgGuid, integrationType: cloudAccount.Type})
+			}
+		}
+		return existing, nil
+	}
+}
+
+func containerRegistryLookup(registryType string) existingIntegrationLookup {
+	return func(lacework *api.Client, d *schema.ResourceData) ([]existingIntegration, error) {
+		response, err := lacework.V2.ContainerRegistries.List()
+		if err != nil {
+			return nil, err
+		}
+
+		existing := make([]existingIntegration, 0)
+		for _, registry := range response.Data {
+			if registry.ContainerRegistryType().String() == registryType &&
+				registry.Name == d.Get("name").(string) {
+				existing = append(existing, existingIntegration{guid: registry.IntgGuid, integrationType: registryType})
+			}
+		}
+		return existing, nil
+	}
+}
@@ -0,0 +1,143 @@
+package lacework
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lacework/go-sdk/api"
+)
+
+func TestIntegrationConflictLookupsResources(t *testing.T) {
+	resources := Provider().ResourcesMap
+	for name := range integrationConflictLookups {
+		assert.Contains(t, resources, name)
+	}
+}
+
+func TestCreateOrUseExisting(t *testing.T) {
+	var (
+		created, updated bool
+		existing         []existingIntegration
+		resource         = &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {Type: schema.TypeString, Required: true},
+			},
+			Create: func(d *schema.ResourceData, _ interface{}) error {
+				created = true
+				d.SetId("NEW_GUID")
+				return nil
+			},
+			Update: func(d *schema.ResourceData, _ interface{}) error {
+				updated = true
+				return nil
+			},
+		}
+		create = createOrUseExisting("lacework_alert_channel_email", resource,
+			func(_ *api.Client, _ *schema.ResourceData) ([]existingIntegration, error) {
+				return existing, nil
+			},
+		)
+		run = func(meta *providerMeta) (*schema.ResourceData, error) {
+			created, updated = false, false
+			d := resource.TestResourceData()
+			d.Set("name", "my-channel")
+			return d, create(d, meta)
+		}
+	)
+
+	// disabled, even if there is an existing alert channel
+	existing = []existingIntegration{{guid: "EXISTING_GUID", integrationType: "EmailUser"}}
+	d, err := run(&providerMeta{})
+	if assert.NoError(t, err) {
+		assert.True(t, created)
+		assert.Equal(t, "NEW_GUID", d.Id())
+	}
+
+	// nothing to use or to fail on
+	existing = []existingIntegration{}
+	for _, mode := range integrationConflictModes {
+		d, err = run(&providerMeta{integrationConflictMode: mode})
+		if assert.NoError(t, err, mode) {
+			assert.True(t, created, mode)
+			assert.Equal(t, "NEW_GUID", d.Id(), mode)
+		}
+	}
+
+	// use the existing alert channel
+	existing = []existingIntegration{{guid: "EXISTING_GUID", integrationType: "EmailUser"}}
+	d, err = run(&providerMeta{integrationConflictMode: integrationConflictAdopt})
+	if assert.NoError(t, err) {
+		assert.False(t, created)
+		assert.True(t, updated)
+		assert.Equal(t, "EXISTING_GUID", d.Id())
+	}
+
+	// fail on the existing alert channel
+	d, err = run(&providerMeta{integrationConflictMode: integrationConflictFail})
+	if assert.Error(t, err) {
+		assert.False(t, created)
+		assert.False(t, updated)
+		assert.Empty(t, d.Id())
+		assert.Equal(t,
+			"Found existing lacework_alert_channel_email with name 'my-channel' and type 'EmailUser' "+
+				"(EXISTING_GUID). Import it, rename the resource, or set use_existing_on_conflict to 'adopt' to use it.",
+			err.Error())
+	}
+
+	// duplicates
+	existing = []existingIntegration{
+		{guid: "GUID_1", integrationType: "EmailUser"},
+		{guid: "GUID_2", integrationType: "EmailUser"},
+	}
+	_, err = run(&providerMeta{integrationConflictMode: integrationConflictAdopt})
+	if assert.Error(t, err) {
+		assert.False(t, created)
+		assert.Contains(t, err.Error(),
+			"Found 2 existing lacework_alert_channel_email with name 'my-channel' (GUID_1, GUID_2)")
+	}
+	_, err = run(&providerMeta{integrationConflictMode: integrationConflictFail})
+	if assert.Error(t, err) {
+		assert.False(t, created)
+		assert.Contains(t, err.Error(), "and type 'EmailUser' (GUID_1, GUID_2)")
+	}
+}
+
+func TestJiraAlertChannelLookup(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/AlertChannels", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+  "data": [
+    {"intgGuid": "CLOUD_GUID", "name": "jira", "type": "Jira", "enabled": 1, "data": {"jiraType": "JIRA_CLOUD"}},
+    {"intgGuid": "SERVER_GUID", "name": "jira", "type": "Jira", "enabled": 1, "data": {"jiraType": "JIRA_SERVER"}},
+    {"intgGuid": "OTHER_GUID", "name": "other", "type": "Jira", "enabled": 1, "data": {"jiraType": "JIRA_CLOUD"}}
+  ]
+}`))
+	}))
+	defer server.Close()
+
+	lacework, err := api.NewClient("test",
+		api.WithURL(server.URL),
+		api.WithToken("TOKEN"),
+	)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	for name, expected := range map[string]string{
+		"lacework_alert_channel_jira_cloud":  "CLOUD_GUID",
+		"lacework_alert_channel_jira_server": "SERVER_GUID",
+	} {
+		d := Provider().ResourcesMap[name].TestResourceData()
+		d.Set("name", "jira")
+
+		existing, err := integrationConflictLookups[name](lacework, d)
+		if assert.NoError(t, err, name) {
+			assert.Equal(t, []existingIntegration{{guid: expected, integrationType: "Jira"}}, existing, name)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package lacework
+
+import (
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/pkg/errors"
+)
+
+// integrationDataSchema is the schema of the raw data of generic integration resources,
+// a JSON document with the same shape as the 'data' field of the Lacework APIv2
+//
+// Since the API never returns secrets, the configured JSON is kept in the state as is
+// and it is only read from the server when importing the resource
+func integrationDataSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeString,
+		Required:         true,
+		Sensitive:        true,
+		ValidateFunc:     validation.StringIsJSON,
+		DiffSuppressFunc: structure.SuppressJsonDiff,
+		StateFunc: func(val interface{}) string {
+			normalized, _ := structure.NormalizeJsonString(val)
+			return normalized
+		},
+		Description: description,
+	}
+}
+
+// expandIntegrationData decodes the raw data of a generic integration resource
+func expandIntegrationData(d *schema.ResourceData) (map[string]interface{}, error) {
+	data := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(d.Get("data").(string)), &data); err != nil {
+		return nil, errors.Wrap(err, "unable to decode integration data")
+	}
+	return data, nil
+}
+
+// setIntegrationData sets the raw data of a generic integration resource from the
+// data returned by the server, only if the resource has no data yet (import)
+func setIntegrationData(d *schema.ResourceData, data interface{}) error {
+	if d.Get("data").(string) != "" {
+		return nil
+	}
+
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return errors.Wrap(err, "unable to encode integration data")
+	}
+	return d.Set("data", string(raw))
+}
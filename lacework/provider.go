@@ -5,11 +5,13 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/lacework/go-sdk/api"
 	"github.com/lacework/go-sdk/lwconfig"
@@ -17,9 +19,18 @@ import (
 	"github.com/lacework/go-sdk/lwlogger"
 )
 
+// providerMeta is passed as the meta argument to every resource and data source,
+// it holds the Lacework API client and the provider-level settings
+type providerMeta struct {
+	client                   *api.Client
+	requireExceptionApproval bool
+	integrationConflictMode  string
+	strictDecoding           bool
+}
+
 // Provider returns a Lacework schema.Provider
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"profile": {
 				Type:        schema.TypeString,
@@ -63,10 +74,44 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("LW_ORGANIZATION", nil),
 				Description: "Set it to true to access organization level data sets (org admins only)",
 			},
+			"api_log_level": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("LW_API_LOG_LEVEL", nil),
+				ValidateFunc: validation.StringInSlice(apiLogLevels, true),
+				Description:  "The log level of the Lacework API traffic, one of ERROR, INFO or DEBUG (defaults to TF_LOG)",
+			},
+			"api_cache_ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  apiCacheTTLDefaultFunc,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The number of seconds that responses of API read requests are cached, the cache is disabled by default",
+			},
+			"use_existing_on_conflict": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("LW_USE_EXISTING_ON_CONFLICT", nil),
+				ValidateFunc: validation.StringInSlice(integrationConflictModes, false),
+				Description:  "What to do when an integration or alert channel with the same name and type exists, either adopt or fail",
+			},
+			"strict_decoding": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("LW_STRICT_DECODING", nil),
+				Description: "Set it to true to fail when the Lacework API returns integration settings unknown to the Lacework Go SDK used by the provider",
+			},
+			"require_exception_approval": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("LW_REQUIRE_EXCEPTION_APPROVAL", nil),
+				Description: "Set it to true to require the approved_by and ticket_ref arguments on all exception resources",
+			},
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
 			"lacework_agent_access_token":                     resourceLaceworkAgentAccessToken(),
+			"lacework_alert_channel":                          resourceLaceworkAlertChannel(),
 			"lacework_alert_channel_aws_cloudwatch":           resourceLaceworkAlertChannelAwsCloudWatch(),
 			"lacework_alert_channel_aws_s3":                   resourceLaceworkAlertChannelAwsS3(),
 			"lacework_alert_channel_cisco_webex":              resourceLaceworkAlertChannelCiscoWebex(),
@@ -86,10 +131,12 @@ func Provider() *schema.Provider {
 			"lacework_alert_channel_webhook":                  resourceLaceworkAlertChannelWebhook(),
 			"lacework_alert_profile":                          resourceLaceworkAlertProfile(),
 			"lacework_alert_rule":                             resourceLaceworkAlertRule(),
+			"lacework_cloud_account":                          resourceLaceworkCloudAccount(),
 			"lacework_data_export_rule":                       resourceLaceworkDataExportRule(),
 			"lacework_external_id":                            resourceLaceworkExternalID(),
 			"lacework_integration_aws_agentless_scanning":     resourceLaceworkIntegrationAwsAgentlessScanning(),
 			"lacework_integration_aws_org_agentless_scanning": resourceLaceworkIntegrationAwsOrgAgentlessScanning(),
+			"lacework_integration_aws_org_cfg":                resourceLaceworkIntegrationAwsOrgCfg(),
 			"lacework_integration_aws_cfg":                    resourceLaceworkIntegrationAwsCfg(),
 			"lacework_integration_aws_ct":                     resourceLaceworkIntegrationAwsCloudTrail(),
 			"lacework_integration_aws_eks_audit_log":          resourceLaceworkIntegrationAwsEksAuditLog(),
@@ -130,13 +177,22 @@ func Provider() *schema.Provider {
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"lacework_api_token":          dataSourceLaceworkApiToken(),
-			"lacework_agent_access_token": dataSourceLaceworkAgentAccessToken(),
-			"lacework_user_profile":       dataSourceLaceworkUserProfile(),
+			"lacework_api_token":                 dataSourceLaceworkApiToken(),
+			"lacework_agent_access_token":        dataSourceLaceworkAgentAccessToken(),
+			"lacework_agent_fleet_health":        dataSourceLaceworkAgentFleetHealth(),
+			"lacework_events":                    dataSourceLaceworkEvents(),
+			"lacework_policies":                  dataSourceLaceworkPolicies(),
+			"lacework_queries":                   dataSourceLaceworkQueries(),
+			"lacework_suppression_migration":     dataSourceLaceworkSuppressionMigration(),
+			"lacework_user_profile":              dataSourceLaceworkUserProfile(),
+			"lacework_vulnerability_scan_status": dataSourceLaceworkVulnerabilityScanStatus(),
 		},
 
 		ConfigureContextFunc: providerConfigure,
 	}
+
+	useExistingOnConflict(provider.ResourcesMap)
+	return provider
 }
 
 func providerConfigure(_ context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
@@ -147,16 +203,26 @@ func providerConfigure(_ context.Context, d *schema.ResourceData) (interface{},
 		account      = d.Get("account").(string)
 		subaccount   = d.Get("subaccount").(string)
 		organization = d.Get("organization").(bool)
-		key          = d.Get("api_key").(string)
-		secret       = d.Get("api_secret").(string)
-		token        = d.Get("api_token").(string)
-		userAgent    = fmt.Sprintf("Terraform/%s", version)
-		apiOpts      = []api.Option{
+		meta         = &providerMeta{
+			requireExceptionApproval: d.Get("require_exception_approval").(bool),
+			integrationConflictMode:  d.Get("use_existing_on_conflict").(string),
+			strictDecoding:           d.Get("strict_decoding").(bool),
+		}
+		key       = d.Get("api_key").(string)
+		secret    = d.Get("api_secret").(string)
+		token     = d.Get("api_token").(string)
+		userAgent = fmt.Sprintf("Terraform/%s", version)
+		apiOpts   = []api.Option{
 			api.WithHeader("User-Agent", userAgent),
 			api.WithTimeout(time.Second * 125), // this is our nginx max time
 		}
 	)
 
+	// the api log level takes precedence over the Terraform log level
+	if apiLogLevel := d.Get("api_log_level").(string); apiLogLevel != "" {
+		logLevel = strings.ToUpper(apiLogLevel)
+	}
+
 	// validate that the log level is supported by the api client, if not,
 	// use the highest supported level just to help the user troubleshoot
 	if logLevel != "" {
@@ -165,7 +231,34 @@ func providerConfigure(_ context.Context, d *schema.ResourceData) (interface{},
 			log.Println("[INFO] Using the 'DEBUG' as the default level")
 			logLevel = "DEBUG"
 		}
-		apiOpts = append(apiOpts, api.WithLogLevelAndWriter(logLevel, log.Writer()))
+
+		// the api traffic is logged by the provider transport with secrets redacted,
+		// the api client debug logs are not used since they include credentials
+		clientLogLevel := logLevel
+		if clientLogLevel == apiLogLevelDebug {
+			clientLogLevel = apiLogLevelInfo
+		}
+		apiOpts = append(apiOpts, api.WithLogLevelAndWriter(clientLogLevel, log.Writer()))
+	}
+	cacheTTL := time.Duration(d.Get("api_cache_ttl").(int)) * time.Second
+	mock := apiMockConfigFromEnv()
+	apiOpts = append(apiOpts, api.WithTransport(newApiTransport(logLevel, cacheTTL, mock)))
+
+	// the mock mode is only used by acceptance tests, requests go to a local mock server
+	// or to recorded fixtures, so any credentials are valid and they are never exchanged
+	if mock.offline() {
+		log.Println("[WARN] Using the Lacework API mock mode, TLS verification and the token exchange are disabled")
+		if account == "" {
+			account = apiMockAccount
+		}
+		if token == "" {
+			token = apiMockToken
+		}
+		// the API keys are ignored so the client is keyless and never requests a token
+		key, secret = "", ""
+		if mock.url != "" {
+			apiOpts = append(apiOpts, api.WithURL(mock.url))
+		}
 	}
 
 	// gracefully handle user input for account config like '<ACCOUNT>.lacework.net'
@@ -184,9 +277,7 @@ func providerConfigure(_ context.Context, d *schema.ResourceData) (interface{},
 
 	// authentication via environment variables or static credentials
 	if validStaticCredentials(account, key, secret, token) {
-		if token != "" {
-			apiOpts = append(apiOpts, api.WithToken(token))
-		}
+		apiOpts = append(apiOpts, apiTokenOptions(token, key == "" || secret == "")...)
 
 		if key != "" && secret != "" {
 			apiOpts = append(apiOpts, api.WithApiKeys(key, secret))
@@ -210,7 +301,8 @@ func providerConfigure(_ context.Context, d *schema.ResourceData) (interface{},
 				Detail:   err.Error(),
 			})
 		}
-		return lw, diags
+		meta.client = lw
+		return meta, diags
 	}
 
 	// authentication via configuration file
@@ -270,11 +362,11 @@ func providerConfigure(_ context.Context, d *schema.ResourceData) (interface{},
 		secret = config.ApiSecret
 	}
 
-	if token != "" {
-		apiOpts = append(apiOpts, api.WithToken(token))
-	}
+	apiOpts = append(apiOpts, apiTokenOptions(token, key == "" || secret == "")...)
 
-	apiOpts = append(apiOpts, api.WithApiKeys(key, secret))
+	if key != "" && secret != "" {
+		apiOpts = append(apiOpts, api.WithApiKeys(key, secret))
+	}
 
 	if config.Version == 2 {
 		// if the config comes back as v2, it means that it is ready to be used
@@ -325,7 +417,8 @@ func providerConfigure(_ context.Context, d *schema.ResourceData) (interface{},
 			Detail:   err.Error(),
 		})
 	}
-	return lw, diags
+	meta.client = lw
+	return meta, diags
 }
 
 func verifyPrimaryAccount(account string, opts ...api.Option) (string, error) {
@@ -369,6 +462,13 @@ Refer to the provider documentation for more information:
   https://www.terraform.io/docs/providers/lacework/index.html`, account)
 }
 
+func apiCacheTTLDefaultFunc() (interface{}, error) {
+	if ttl := os.Getenv("LW_API_CACHE_TTL"); ttl != "" {
+		return strconv.Atoi(ttl)
+	}
+	return apiCacheDefaultTTL, nil
+}
+
 func fileExist(name string) bool {
 	_, err := os.Stat(name)
 	return !os.IsNotExist(err)
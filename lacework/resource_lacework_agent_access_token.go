@@ -59,7 +59,7 @@ func resourceLaceworkAgentAccessToken() *schema.Resource {
 
 func resourceLaceworkAgentAccessTokenCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework     = meta.(*api.Client)
+		lacework     = meta.(*providerMeta).client
 		tokenName    = d.Get("name").(string)
 		tokenDesc    = d.Get("description").(string)
 		tokenEnabled = d.Get("enabled").(bool)
@@ -97,7 +97,7 @@ func resourceLaceworkAgentAccessTokenCreate(d *schema.ResourceData, meta interfa
 }
 
 func resourceLaceworkAgentAccessTokenRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Reading agent access token.")
 	response, err := lacework.V2.AgentAccessTokens.Get(d.Get("token").(string))
@@ -126,7 +126,7 @@ func resourceLaceworkAgentAccessTokenRead(d *schema.ResourceData, meta interface
 
 func resourceLaceworkAgentAccessTokenUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		token    = api.AgentAccessTokenRequest{
 			TokenAlias: d.Get("name").(string),
 			Enabled:    0,
@@ -163,7 +163,7 @@ func resourceLaceworkAgentAccessTokenUpdate(d *schema.ResourceData, meta interfa
 
 func resourceLaceworkAgentAccessTokenDelete(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework  = meta.(*api.Client)
+		lacework  = meta.(*providerMeta).client
 		tokenName = fmt.Sprintf("%s-%s-deleted", d.Get("name").(string), randomString(5))
 	)
 
@@ -182,7 +182,7 @@ func resourceLaceworkAgentAccessTokenDelete(d *schema.ResourceData, meta interfa
 }
 
 func importLaceworkAgentAccessToken(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Importing agent access token.")
 	response, err := lacework.V2.AgentAccessTokens.Get(d.Id())
@@ -0,0 +1,250 @@
+package lacework
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/lacework/go-sdk/api"
+)
+
+// alertChannelDataTypes are the alert channel types known by the provider, mapped to
+// a function that returns the Go SDK structure used to validate their data
+var alertChannelDataTypes = map[string]func() interface{}{
+	api.CloudwatchEbAlertChannelType.String():      func() interface{} { return new(api.CloudwatchEbDataV2) },
+	api.AwsS3AlertChannelType.String():             func() interface{} { return new(api.AwsS3DataV2) },
+	api.CiscoSparkWebhookAlertChannelType.String(): func() interface{} { return new(api.CiscoSparkWebhookDataV2) },
+	api.DatadogAlertChannelType.String():           func() interface{} { return new(api.DatadogDataV2) },
+	api.EmailUserAlertChannelType.String():         func() interface{} { return new(api.EmailUserData) },
+	api.GcpPubSubAlertChannelType.String():         func() interface{} { return new(api.GcpPubSubDataV2) },
+	api.IbmQRadarAlertChannelType.String():         func() interface{} { return new(api.IbmQRadarDataV2) },
+	api.JiraAlertChannelType.String():              func() interface{} { return new(api.JiraDataV2) },
+	api.MicrosoftTeamsAlertChannelType.String():    func() interface{} { return new(api.MicrosoftTeamsData) },
+	api.NewRelicInsightsAlertChannelType.String():  func() interface{} { return new(api.NewRelicInsightsDataV2) },
+	api.PagerDutyApiAlertChannelType.String():      func() interface{} { return new(api.PagerDutyApiDataV2) },
+	api.ServiceNowRestAlertChannelType.String():    func() interface{} { return new(api.ServiceNowRestDataV2) },
+	api.SlackChannelAlertChannelType.String():      func() interface{} { return new(api.SlackChannelDataV2) },
+	api.SplunkHecAlertChannelType.String():         func() interface{} { return new(api.SplunkHecDataV2) },
+	api.VictorOpsAlertChannelType.String():         func() interface{} { return new(api.VictorOpsDataV2) },
+	api.WebhookAlertChannelType.String():           func() interface{} { return new(api.WebhookDataV2) },
+}
+
+func resourceLaceworkAlertChannel() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceLaceworkAlertChannelCreate,
+		Read:          resourceLaceworkAlertChannelRead,
+		Update:        resourceLaceworkAlertChannelUpdate,
+		Delete:        resourceLaceworkAlertChannelDelete,
+		CustomizeDiff: validateAlertChannelData,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The integration name",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "The alert channel type, for example EmailUser or SlackChannel",
+			},
+			"data": integrationDataSchema(
+				"The JSON encoded data of the alert channel, as documented by the Lacework APIv2",
+			),
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "The state of the external integration",
+			},
+			"test_integration": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether to test the integration of an alert channel upon creation and modification",
+			},
+			"intg_guid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_or_updated_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_or_updated_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"org_level": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// validateAlertChannelData is a CustomizeDiff function that validates, at plan time, the
+// data of the alert channel types known by the provider, the data of unknown types is
+// passed as is to the Lacework API
+func validateAlertChannelData(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if !diff.NewValueKnown("type") || !diff.NewValueKnown("data") {
+		return nil
+	}
+
+	return validateAlertChannelTypeData(diff.Get("type").(string), diff.Get("data").(string))
+}
+
+func validateAlertChannelTypeData(channelType, data string) error {
+	newData, known := alertChannelDataTypes[channelType]
+	if !known {
+		return nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader([]byte(data)))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(newData()); err != nil {
+		return fmt.Errorf("invalid data for alert channel type %s: %s", channelType, err)
+	}
+	return nil
+}
+
+// buildAlertChannelRaw returns an alert channel of any type, including the types
+// that the Lacework Go SDK doesn't know about, since the data is passed as is
+func buildAlertChannelRaw(d *schema.ResourceData) (api.AlertChannelRaw, error) {
+	data, err := expandIntegrationData(d)
+	if err != nil {
+		return api.AlertChannelRaw{}, err
+	}
+
+	channelType := d.Get("type").(string)
+	if _, known := alertChannelDataTypes[channelType]; !known {
+		log.Printf("[INFO] Alert channel type %s is not known by the provider, using data as is\n", channelType)
+	}
+
+	alertChannel := api.NewAlertChannel(d.Get("name").(string), api.NoneAlertChannelType, data)
+	alertChannel.Type = channelType
+	if !d.Get("enabled").(bool) {
+		alertChannel.Enabled = 0
+	}
+	return alertChannel, nil
+}
+
+func setAlertChannelCommon(d *schema.ResourceData, alertChannel api.AlertChannelRaw) {
+	d.Set("name", alertChannel.Name)
+	d.Set("intg_guid", alertChannel.IntgGuid)
+	d.Set("enabled", alertChannel.Enabled == 1)
+	d.Set("created_or_updated_time", alertChannel.CreatedOrUpdatedTime)
+	d.Set("created_or_updated_by", alertChannel.CreatedOrUpdatedBy)
+	d.Set("type_name", alertChannel.Type)
+	d.Set("org_level", alertChannel.IsOrg == 1)
+}
+
+func resourceLaceworkAlertChannelCreate(d *schema.ResourceData, meta interface{}) error {
+	lacework := meta.(*providerMeta).client
+
+	alertChannel, err := buildAlertChannelRaw(d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating %s alert channel\n", alertChannel.Type)
+	response, err := lacework.V2.AlertChannels.Create(alertChannel)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(response.Data.IntgGuid)
+	setAlertChannelCommon(d, response.Data)
+
+	if d.Get("test_integration").(bool) {
+		log.Printf("[INFO] Testing %s integration for guid %s\n", alertChannel.Type, d.Id())
+		if err := VerifyAlertChannelAndRollback(d, lacework); err != nil {
+			return err
+		}
+		log.Printf("[INFO] Tested %s integration with guid %s successfully\n", alertChannel.Type, d.Id())
+	}
+
+	log.Printf("[INFO] Created %s alert channel with guid: %s\n", alertChannel.Type, response.Data.IntgGuid)
+	return nil
+}
+
+func resourceLaceworkAlertChannelRead(d *schema.ResourceData, meta interface{}) error {
+	var response api.AlertChannelResponse
+
+	log.Printf("[INFO] Reading alert channel with guid: %s\n", d.Id())
+	err := getAlertChannel(meta, d.Id(), &response)
+	if err != nil {
+		return resourceNotFound(d, err)
+	}
+
+	setAlertChannelCommon(d, response.Data)
+	d.Set("type", response.Data.Type)
+	if err := setIntegrationData(d, response.Data.Data); err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Read %s alert channel with guid: %s\n", response.Data.Type, response.Data.IntgGuid)
+	return nil
+}
+
+func resourceLaceworkAlertChannelUpdate(d *schema.ResourceData, meta interface{}) error {
+	var (
+		lacework = meta.(*providerMeta).client
+		response api.AlertChannelResponse
+	)
+
+	alertChannel, err := buildAlertChannelRaw(d)
+	if err != nil {
+		return err
+	}
+
+	alertChannel.IntgGuid = d.Id()
+
+	log.Printf("[INFO] Updating %s alert channel with guid: %s\n", alertChannel.Type, d.Id())
+	err = lacework.RequestEncoderDecoder("PATCH",
+		fmt.Sprintf("v2/AlertChannels/%s", d.Id()), alertChannel, &response)
+	if err != nil {
+		return err
+	}
+
+	setAlertChannelCommon(d, response.Data)
+
+	if d.Get("test_integration").(bool) {
+		log.Printf("[INFO] Testing %s integration for guid %s\n", alertChannel.Type, d.Id())
+		if err := lacework.V2.AlertChannels.Test(d.Id()); err != nil {
+			return err
+		}
+		log.Printf("[INFO] Tested %s integration with guid: %s successfully\n", alertChannel.Type, d.Id())
+	}
+
+	log.Printf("[INFO] Updated %s alert channel with guid %s\n", alertChannel.Type, d.Id())
+	return nil
+}
+
+func resourceLaceworkAlertChannelDelete(d *schema.ResourceData, meta interface{}) error {
+	lacework := meta.(*providerMeta).client
+
+	log.Printf("[INFO] Deleting %s alert channel with guid: %s\n", d.Get("type").(string), d.Id())
+	err := lacework.V2.AlertChannels.Delete(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleted %s alert channel with guid: %s\n", d.Get("type").(string), d.Id())
+	return nil
+}
@@ -88,7 +88,7 @@ func resourceLaceworkAlertChannelAwsCloudWatch() *schema.Resource {
 
 func resourceLaceworkAlertChannelAwsCloudWatchCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		alert    = api.NewAlertChannel(d.Get("name").(string),
 			api.CloudwatchEbAlertChannelType,
 			api.CloudwatchEbDataV2{
@@ -130,10 +130,10 @@ func resourceLaceworkAlertChannelAwsCloudWatchCreate(d *schema.ResourceData, met
 }
 
 func resourceLaceworkAlertChannelAwsCloudWatchRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	var response api.CloudwatchEbAlertChannelResponseV2
 
 	log.Printf("[INFO] Reading %s integration with guid %s\n", api.CloudwatchEbAlertChannelType, d.Id())
-	response, err := lacework.V2.AlertChannels.GetCloudwatchEb(d.Id())
+	err := getAlertChannel(meta, d.Id(), &response)
 	if err != nil {
 		return resourceNotFound(d, err)
 	}
@@ -156,7 +156,7 @@ func resourceLaceworkAlertChannelAwsCloudWatchRead(d *schema.ResourceData, meta
 
 func resourceLaceworkAlertChannelAwsCloudWatchUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		alert    = api.NewAlertChannel(d.Get("name").(string),
 			api.CloudwatchEbAlertChannelType,
 			api.CloudwatchEbDataV2{
@@ -200,7 +200,7 @@ func resourceLaceworkAlertChannelAwsCloudWatchUpdate(d *schema.ResourceData, met
 }
 
 func resourceLaceworkAlertChannelAwsCloudWatchDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s integration with guid %s\n", api.CloudwatchEbAlertChannelType, d.Id())
 	err := lacework.V2.AlertChannels.Delete(d.Id())
@@ -87,7 +87,7 @@ func resourceLaceworkAlertChannelAwsS3() *schema.Resource {
 
 func resourceLaceworkAlertChannelAwsS3Create(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		s3       = api.NewAlertChannel(d.Get("name").(string),
 			api.AwsS3AlertChannelType,
 			api.AwsS3DataV2{
@@ -132,10 +132,10 @@ func resourceLaceworkAlertChannelAwsS3Create(d *schema.ResourceData, meta interf
 }
 
 func resourceLaceworkAlertChannelAwsS3Read(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	var response api.AwsS3AlertChannelResponseV2
 
 	log.Printf("[INFO] Reading %s integration with guid %s\n", api.AwsS3AlertChannelType, d.Id())
-	response, err := lacework.V2.AlertChannels.GetAwsS3(d.Id())
+	err := getAlertChannel(meta, d.Id(), &response)
 	if err != nil {
 		return resourceNotFound(d, err)
 	}
@@ -162,7 +162,7 @@ func resourceLaceworkAlertChannelAwsS3Read(d *schema.ResourceData, meta interfac
 
 func resourceLaceworkAlertChannelAwsS3Update(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		s3       = api.NewAlertChannel(d.Get("name").(string),
 			api.AwsS3AlertChannelType,
 			api.AwsS3DataV2{
@@ -209,7 +209,7 @@ func resourceLaceworkAlertChannelAwsS3Update(d *schema.ResourceData, meta interf
 }
 
 func resourceLaceworkAlertChannelAwsS3Delete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s integration with guid %s\n", api.AwsS3AlertChannelType, d.Id())
 	err := lacework.V2.AlertChannels.Delete(d.Id())
@@ -68,7 +68,7 @@ func resourceLaceworkAlertChannelCiscoWebex() *schema.Resource {
 
 func resourceLaceworkAlertChannelCiscoWebexCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		webex    = api.NewAlertChannel(d.Get("name").(string),
 			api.CiscoSparkWebhookAlertChannelType,
 			api.CiscoSparkWebhookDataV2{
@@ -109,10 +109,10 @@ func resourceLaceworkAlertChannelCiscoWebexCreate(d *schema.ResourceData, meta i
 }
 
 func resourceLaceworkAlertChannelCiscoWebexRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	var response api.CiscoSparkWebhookAlertChannelResponseV2
 
 	log.Printf("[INFO] Reading %s integration with guid %s\n", api.CiscoSparkWebhookAlertChannelType, d.Id())
-	response, err := lacework.V2.AlertChannels.GetCiscoSparkWebhook(d.Id())
+	err := getAlertChannel(meta, d.Id(), &response)
 	if err != nil {
 		return resourceNotFound(d, err)
 	}
@@ -133,7 +133,7 @@ func resourceLaceworkAlertChannelCiscoWebexRead(d *schema.ResourceData, meta int
 
 func resourceLaceworkAlertChannelCiscoWebexUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		webex    = api.NewAlertChannel(d.Get("name").(string),
 			api.CiscoSparkWebhookAlertChannelType,
 			api.CiscoSparkWebhookDataV2{
@@ -176,7 +176,7 @@ func resourceLaceworkAlertChannelCiscoWebexUpdate(d *schema.ResourceData, meta i
 }
 
 func resourceLaceworkAlertChannelCiscoWebexDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s integration with guid %s\n", api.CiscoSparkWebhookAlertChannelType, d.Id())
 	err := lacework.V2.AlertChannels.Delete(d.Id())
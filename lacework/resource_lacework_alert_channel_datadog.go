@@ -110,7 +110,7 @@ func resourceLaceworkAlertChannelDatadogCreate(d *schema.ResourceData, meta inte
 	service, _ := api.DatadogService(d.Get("datadog_service").(string))
 
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		datadog  = api.NewAlertChannel(d.Get("name").(string),
 			api.DatadogAlertChannelType,
 			api.DatadogDataV2{
@@ -154,10 +154,10 @@ func resourceLaceworkAlertChannelDatadogCreate(d *schema.ResourceData, meta inte
 }
 
 func resourceLaceworkAlertChannelDatadogRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	var response api.DatadogAlertChannelResponseV2
 
 	log.Printf("[INFO] Reading %s integration with guid %s\n", api.DatadogAlertChannelType, d.Id())
-	response, err := lacework.V2.AlertChannels.GetDatadog(d.Id())
+	err := getAlertChannel(meta, d.Id(), &response)
 	if err != nil {
 		return resourceNotFound(d, err)
 	}
@@ -182,7 +182,7 @@ func resourceLaceworkAlertChannelDatadogUpdate(d *schema.ResourceData, meta inte
 	service, _ := api.DatadogService(d.Get("datadog_service").(string))
 
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		datadog  = api.NewAlertChannel(d.Get("name").(string),
 			api.DatadogAlertChannelType,
 			api.DatadogDataV2{
@@ -227,7 +227,7 @@ func resourceLaceworkAlertChannelDatadogUpdate(d *schema.ResourceData, meta inte
 }
 
 func resourceLaceworkAlertChannelDatadogDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s integration with guid %s\n", api.DatadogAlertChannelType, d.Id())
 	err := lacework.V2.AlertChannels.Delete(d.Id())
@@ -142,7 +142,7 @@ func resourceLaceworkAlertChannelGcpPubSub() *schema.Resource {
 
 func resourceLaceworkAlertChannelGcpPubSubCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework  = meta.(*api.Client)
+		lacework  = meta.(*providerMeta).client
 		gcpPubSub = api.NewAlertChannel(d.Get("name").(string),
 			api.GcpPubSubAlertChannelType,
 			api.GcpPubSubDataV2{
@@ -191,10 +191,10 @@ func resourceLaceworkAlertChannelGcpPubSubCreate(d *schema.ResourceData, meta in
 }
 
 func resourceLaceworkAlertChannelGcpPubSubRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	var response api.GcpPubSubAlertChannelResponseV2
 
 	log.Printf("[INFO] Reading %s integration with guid %s\n", api.GcpPubSubAlertChannelType, d.Id())
-	response, err := lacework.V2.AlertChannels.GetGcpPubSub(d.Id())
+	err := getAlertChannel(meta, d.Id(), &response)
 	if err != nil {
 		return resourceNotFound(d, err)
 	}
@@ -223,7 +223,7 @@ func resourceLaceworkAlertChannelGcpPubSubRead(d *schema.ResourceData, meta inte
 
 func resourceLaceworkAlertChannelGcpPubSubUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework  = meta.(*api.Client)
+		lacework  = meta.(*providerMeta).client
 		gcpPubSub = api.NewAlertChannel(d.Get("name").(string),
 			api.GcpPubSubAlertChannelType,
 			api.GcpPubSubDataV2{
@@ -274,7 +274,7 @@ func resourceLaceworkAlertChannelGcpPubSubUpdate(d *schema.ResourceData, meta in
 }
 
 func resourceLaceworkAlertChannelGcpPubSubDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s integration with guid %s\n", api.GcpPubSubAlertChannelType, d.Id())
 	err := lacework.V2.AlertChannels.Delete(d.Id())
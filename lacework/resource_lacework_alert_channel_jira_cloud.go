@@ -133,7 +133,7 @@ func resourceLaceworkAlertChannelJiraCloud() *schema.Resource {
 
 func resourceLaceworkAlertChannelJiraCloudCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework           = meta.(*api.Client)
+		lacework           = meta.(*providerMeta).client
 		customTemplateJSON = d.Get("custom_template_file").(string)
 		jiraData           = api.JiraDataV2{
 			JiraUrl:       d.Get("jira_url").(string),
@@ -185,10 +185,10 @@ func resourceLaceworkAlertChannelJiraCloudCreate(d *schema.ResourceData, meta in
 }
 
 func resourceLaceworkAlertChannelJiraCloudRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	var response api.JiraAlertChannelResponseV2
 
 	log.Printf("[INFO] Reading %s integration with guid %s\n", api.JiraAlertChannelType, d.Id())
-	response, err := lacework.V2.AlertChannels.GetJira(d.Id())
+	err := getAlertChannel(meta, d.Id(), &response)
 	if err != nil {
 		return resourceNotFound(d, err)
 	}
@@ -223,7 +223,7 @@ func resourceLaceworkAlertChannelJiraCloudRead(d *schema.ResourceData, meta inte
 
 func resourceLaceworkAlertChannelJiraCloudUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework           = meta.(*api.Client)
+		lacework           = meta.(*providerMeta).client
 		customTemplateJSON = d.Get("custom_template_file").(string)
 		jiraData           = api.JiraDataV2{
 			JiraUrl:       d.Get("jira_url").(string),
@@ -276,7 +276,7 @@ func resourceLaceworkAlertChannelJiraCloudUpdate(d *schema.ResourceData, meta in
 }
 
 func resourceLaceworkAlertChannelJiraCloudDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s integration with guid %s\n", api.JiraAlertChannelType, d.Id())
 	err := lacework.V2.AlertChannels.Delete(d.Id())
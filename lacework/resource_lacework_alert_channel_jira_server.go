@@ -131,7 +131,7 @@ func resourceLaceworkAlertChannelJiraServer() *schema.Resource {
 
 func resourceLaceworkAlertChannelJiraServerCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework           = meta.(*api.Client)
+		lacework           = meta.(*providerMeta).client
 		customTemplateJSON = d.Get("custom_template_file").(string)
 		jiraData           = api.JiraDataV2{
 			JiraUrl:       d.Get("jira_url").(string),
@@ -183,10 +183,10 @@ func resourceLaceworkAlertChannelJiraServerCreate(d *schema.ResourceData, meta i
 }
 
 func resourceLaceworkAlertChannelJiraServerRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	var response api.JiraAlertChannelResponseV2
 
 	log.Printf("[INFO] Reading %s integration with guid %s\n", api.JiraAlertChannelType, d.Id())
-	response, err := lacework.V2.AlertChannels.GetJira(d.Id())
+	err := getAlertChannel(meta, d.Id(), &response)
 	if err != nil {
 		return resourceNotFound(d, err)
 	}
@@ -221,7 +221,7 @@ func resourceLaceworkAlertChannelJiraServerRead(d *schema.ResourceData, meta int
 
 func resourceLaceworkAlertChannelJiraServerUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework           = meta.(*api.Client)
+		lacework           = meta.(*providerMeta).client
 		customTemplateJSON = d.Get("custom_template_file").(string)
 		jiraData           = api.JiraDataV2{
 			JiraUrl:       d.Get("jira_url").(string),
@@ -274,7 +274,7 @@ func resourceLaceworkAlertChannelJiraServerUpdate(d *schema.ResourceData, meta i
 }
 
 func resourceLaceworkAlertChannelJiraServerDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s integration with guid %s\n", api.JiraAlertChannelType, d.Id())
 	err := lacework.V2.AlertChannels.Delete(d.Id())
@@ -72,7 +72,7 @@ func resourceLaceworkAlertChannelNewRelic() *schema.Resource {
 
 func resourceLaceworkAlertChannelNewRelicCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		relic    = api.NewAlertChannel(d.Get("name").(string),
 			api.NewRelicInsightsAlertChannelType,
 			api.NewRelicInsightsDataV2{
@@ -114,10 +114,10 @@ func resourceLaceworkAlertChannelNewRelicCreate(d *schema.ResourceData, meta int
 }
 
 func resourceLaceworkAlertChannelNewRelicRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	var response api.NewRelicInsightsAlertChannelResponseV2
 
 	log.Printf("[INFO] Reading %s integration with guid %s\n", api.NewRelicInsightsAlertChannelType, d.Id())
-	response, err := lacework.V2.AlertChannels.GetNewRelicInsights(d.Id())
+	err := getAlertChannel(meta, d.Id(), &response)
 	if err != nil {
 		return resourceNotFound(d, err)
 	}
@@ -141,7 +141,7 @@ func resourceLaceworkAlertChannelNewRelicRead(d *schema.ResourceData, meta inter
 
 func resourceLaceworkAlertChannelNewRelicUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		relic    = api.NewAlertChannel(d.Get("name").(string),
 			api.NewRelicInsightsAlertChannelType,
 			api.NewRelicInsightsDataV2{
@@ -185,7 +185,7 @@ func resourceLaceworkAlertChannelNewRelicUpdate(d *schema.ResourceData, meta int
 }
 
 func resourceLaceworkAlertChannelNewRelicDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s integration with guid %s\n", api.NewRelicInsightsAlertChannelType, d.Id())
 	err := lacework.V2.AlertChannels.Delete(d.Id())
@@ -1,7 +1,9 @@
 package lacework
 
 import (
+	"fmt"
 	"log"
+	"os"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -32,10 +34,22 @@ func resourceLaceworkAlertChannelPagerDuty() *schema.Resource {
 				Description: "The state of the external integration",
 			},
 			"integration_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"integration_key", "integration_key_env"},
+				Description:  "The PagerDuty service integration key",
+			},
+			"integration_key_env": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "The name of an environment variable that holds the PagerDuty service integration key, " +
+					"the key is read when the channel is created or updated and it is not stored in the state",
+			},
+			"integration_key_version": {
 				Type:        schema.TypeString,
-				Required:    true,
-				Sensitive:   true,
-				Description: "The PagerDuty service integration key",
+				Optional:    true,
+				Description: "A value that, when changed, updates the integration key of the channel in place",
 			},
 			"test_integration": {
 				Type:        schema.TypeBool,
@@ -68,20 +82,24 @@ func resourceLaceworkAlertChannelPagerDuty() *schema.Resource {
 }
 
 func resourceLaceworkAlertChannelPagerDutyCreate(d *schema.ResourceData, meta interface{}) error {
-	var (
-		lacework = meta.(*api.Client)
-		alert    = api.NewAlertChannel(d.Get("name").(string),
-			api.PagerDutyApiAlertChannelType,
-			api.PagerDutyApiDataV2{
-				IntegrationKey: d.Get("integration_key").(string),
-			},
-		)
+	lacework := meta.(*providerMeta).client
+
+	integrationKey, err := getPagerDutyIntegrationKey(d)
+	if err != nil {
+		return err
+	}
+
+	alert := api.NewAlertChannel(d.Get("name").(string),
+		api.PagerDutyApiAlertChannelType,
+		api.PagerDutyApiDataV2{
+			IntegrationKey: integrationKey,
+		},
 	)
 	if !d.Get("enabled").(bool) {
 		alert.Enabled = 0
 	}
 
-	log.Printf("[INFO] Creating %s integration with data:\n%+v\n", api.PagerDutyApiAlertChannelType, alert)
+	log.Printf("[INFO] Creating %s integration\n", api.PagerDutyApiAlertChannelType)
 	response, err := lacework.V2.AlertChannels.Create(alert)
 	if err != nil {
 		return err
@@ -111,10 +129,10 @@ func resourceLaceworkAlertChannelPagerDutyCreate(d *schema.ResourceData, meta in
 }
 
 func resourceLaceworkAlertChannelPagerDutyRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	var response api.PagerDutyApiAlertChannelResponseV2
 
 	log.Printf("[INFO] Reading %s integration with guid %s\n", api.PagerDutyApiAlertChannelType, d.Id())
-	response, err := lacework.V2.AlertChannels.GetPagerDutyApi(d.Id())
+	err := getAlertChannel(meta, d.Id(), &response)
 	if err != nil {
 		return resourceNotFound(d, err)
 	}
@@ -134,14 +152,18 @@ func resourceLaceworkAlertChannelPagerDutyRead(d *schema.ResourceData, meta inte
 }
 
 func resourceLaceworkAlertChannelPagerDutyUpdate(d *schema.ResourceData, meta interface{}) error {
-	var (
-		lacework = meta.(*api.Client)
-		alert    = api.NewAlertChannel(d.Get("name").(string),
-			api.PagerDutyApiAlertChannelType,
-			api.PagerDutyApiDataV2{
-				IntegrationKey: d.Get("integration_key").(string),
-			},
-		)
+	lacework := meta.(*providerMeta).client
+
+	integrationKey, err := getPagerDutyIntegrationKey(d)
+	if err != nil {
+		return err
+	}
+
+	alert := api.NewAlertChannel(d.Get("name").(string),
+		api.PagerDutyApiAlertChannelType,
+		api.PagerDutyApiDataV2{
+			IntegrationKey: integrationKey,
+		},
 	)
 
 	if !d.Get("enabled").(bool) {
@@ -150,7 +172,11 @@ func resourceLaceworkAlertChannelPagerDutyUpdate(d *schema.ResourceData, meta in
 
 	alert.IntgGuid = d.Id()
 
-	log.Printf("[INFO] Updating %s integration with data:\n%+v\n", api.PagerDutyApiAlertChannelType, alert)
+	if d.HasChanges("integration_key", "integration_key_env", "integration_key_version") {
+		log.Printf("[INFO] Rotating integration key of %s integration with guid %s\n", api.PagerDutyApiAlertChannelType, d.Id())
+	}
+
+	log.Printf("[INFO] Updating %s integration with guid %s\n", api.PagerDutyApiAlertChannelType, d.Id())
 	response, err := lacework.V2.AlertChannels.UpdatePagerDutyApi(alert)
 	if err != nil {
 		return err
@@ -179,7 +205,7 @@ func resourceLaceworkAlertChannelPagerDutyUpdate(d *schema.ResourceData, meta in
 }
 
 func resourceLaceworkAlertChannelPagerDutyDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s integration with guid %s\n", api.PagerDutyApiAlertChannelType, d.Id())
 	err := lacework.V2.AlertChannels.Delete(d.Id())
@@ -190,3 +216,19 @@ func resourceLaceworkAlertChannelPagerDutyDelete(d *schema.ResourceData, meta in
 	log.Printf("[INFO] Deleted %s integration with guid %s\n", api.PagerDutyApiAlertChannelType, d.Id())
 	return nil
 }
+
+// getPagerDutyIntegrationKey returns the integration key of the PagerDuty alert channel, either
+// from the configuration or from the environment variable configured in integration_key_env
+func getPagerDutyIntegrationKey(d *schema.ResourceData) (string, error) {
+	envName := d.Get("integration_key_env").(string)
+	if envName == "" {
+		return d.Get("integration_key").(string), nil
+	}
+
+	integrationKey := os.Getenv(envName)
+	if integrationKey == "" {
+		return "", fmt.Errorf(
+			"The environment variable %s, configured in integration_key_env, is not set or empty", envName)
+	}
+	return integrationKey, nil
+}
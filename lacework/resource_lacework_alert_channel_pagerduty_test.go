@@ -0,0 +1,37 @@
+package lacework
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetPagerDutyIntegrationKey(t *testing.T) {
+	resource := resourceLaceworkAlertChannelPagerDuty()
+
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"integration_key": "abc123",
+	})
+	integrationKey, err := getPagerDutyIntegrationKey(d)
+	if assert.Nil(t, err) {
+		assert.Equal(t, "abc123", integrationKey)
+	}
+
+	t.Setenv("LW_TEST_PAGERDUTY_KEY", "rotated456")
+	d = schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"integration_key_env": "LW_TEST_PAGERDUTY_KEY",
+	})
+	integrationKey, err = getPagerDutyIntegrationKey(d)
+	if assert.Nil(t, err) {
+		assert.Equal(t, "rotated456", integrationKey)
+	}
+
+	d = schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"integration_key_env": "LW_TEST_PAGERDUTY_KEY_MISSING",
+	})
+	_, err = getPagerDutyIntegrationKey(d)
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "LW_TEST_PAGERDUTY_KEY_MISSING")
+	}
+}
@@ -101,7 +101,7 @@ func resourceLaceworkAlertChannelQRadarCreate(d *schema.ResourceData, meta inter
 	comm, _ := api.QRadarComm(d.Get("communication_type").(string))
 
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		qradar   = api.NewAlertChannel(d.Get("name").(string),
 			api.IbmQRadarAlertChannelType,
 			api.IbmQRadarDataV2{
@@ -145,10 +145,10 @@ func resourceLaceworkAlertChannelQRadarCreate(d *schema.ResourceData, meta inter
 }
 
 func resourceLaceworkAlertChannelQRadarRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	var response api.IbmQRadarAlertChannelResponseV2
 
 	log.Printf("[INFO] Reading %s integration with guid %s\n", api.IbmQRadarAlertChannelType, d.Id())
-	response, err := lacework.V2.AlertChannels.GetIbmQRadar(d.Id())
+	err := getAlertChannel(meta, d.Id(), &response)
 	if err != nil {
 		return resourceNotFound(d, err)
 	}
@@ -174,7 +174,7 @@ func resourceLaceworkAlertChannelQRadarUpdate(d *schema.ResourceData, meta inter
 	comm, _ := api.QRadarComm(d.Get("communication_type").(string))
 
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		qradar   = api.NewAlertChannel(d.Get("name").(string),
 			api.IbmQRadarAlertChannelType,
 			api.IbmQRadarDataV2{
@@ -220,7 +220,7 @@ func resourceLaceworkAlertChannelQRadarUpdate(d *schema.ResourceData, meta inter
 }
 
 func resourceLaceworkAlertChannelQRadarDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s integration with guid %s\n", api.IbmQRadarAlertChannelType, d.Id())
 	err := lacework.V2.AlertChannels.Delete(d.Id())
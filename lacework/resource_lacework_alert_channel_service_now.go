@@ -102,7 +102,7 @@ func resourceLaceworkAlertChannelServiceNow() *schema.Resource {
 
 func resourceLaceworkAlertChannelServiceNowCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework           = meta.(*api.Client)
+		lacework           = meta.(*providerMeta).client
 		customTemplateJSON = d.Get("custom_template_file").(string)
 		snowData           = api.ServiceNowRestDataV2{
 			InstanceURL:   d.Get("instance_url").(string),
@@ -144,10 +144,10 @@ func resourceLaceworkAlertChannelServiceNowCreate(d *schema.ResourceData, meta i
 }
 
 func resourceLaceworkAlertChannelServiceNowRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	var response api.ServiceNowRestAlertChannelResponseV2
 
 	log.Printf("[INFO] Reading %s integration with guid %s\n", api.ServiceNowRestAlertChannelType, d.Id())
-	response, err := lacework.V2.AlertChannels.GetServiceNowRest(d.Id())
+	err := getAlertChannel(meta, d.Id(), &response)
 	if err != nil {
 		return resourceNotFound(d, err)
 	}
@@ -170,7 +170,7 @@ func resourceLaceworkAlertChannelServiceNowRead(d *schema.ResourceData, meta int
 
 func resourceLaceworkAlertChannelServiceNowUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework           = meta.(*api.Client)
+		lacework           = meta.(*providerMeta).client
 		customTemplateJSON = d.Get("custom_template_file").(string)
 		snowData           = api.ServiceNowRestDataV2{
 			InstanceURL:   d.Get("instance_url").(string),
@@ -221,7 +221,7 @@ func resourceLaceworkAlertChannelServiceNowUpdate(d *schema.ResourceData, meta i
 }
 
 func resourceLaceworkAlertChannelServiceNowDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s integration with guid %s\n", api.ServiceNowRestAlertChannelType, d.Id())
 	err := lacework.V2.AlertChannels.Delete(d.Id())
@@ -68,7 +68,7 @@ func resourceLaceworkAlertChannelSlack() *schema.Resource {
 
 func resourceLaceworkAlertChannelSlackCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		slack    = api.NewAlertChannel(d.Get("name").(string),
 			api.SlackChannelAlertChannelType,
 			api.SlackChannelDataV2{
@@ -109,10 +109,10 @@ func resourceLaceworkAlertChannelSlackCreate(d *schema.ResourceData, meta interf
 }
 
 func resourceLaceworkAlertChannelSlackRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	var response api.SlackChannelAlertChannelResponseV2
 
 	log.Printf("[INFO] Reading %s integration with guid %s\n", api.SlackChannelAlertChannelType, d.Id())
-	response, err := lacework.V2.AlertChannels.GetSlackChannel(d.Id())
+	err := getAlertChannel(meta, d.Id(), &response)
 	if err != nil {
 		return resourceNotFound(d, err)
 	}
@@ -133,7 +133,7 @@ func resourceLaceworkAlertChannelSlackRead(d *schema.ResourceData, meta interfac
 
 func resourceLaceworkAlertChannelSlackUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		slack    = api.NewAlertChannel(d.Get("name").(string),
 			api.SlackChannelAlertChannelType,
 			api.SlackChannelDataV2{
@@ -176,7 +176,7 @@ func resourceLaceworkAlertChannelSlackUpdate(d *schema.ResourceData, meta interf
 }
 
 func resourceLaceworkAlertChannelSlackDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s integration with guid %s\n", api.SlackChannelAlertChannelType, d.Id())
 	err := lacework.V2.AlertChannels.Delete(d.Id())
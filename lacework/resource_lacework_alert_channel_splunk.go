@@ -115,7 +115,7 @@ func resourceLaceworkAlertChannelSplunk() *schema.Resource {
 
 func resourceLaceworkAlertChannelSplunkCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		splunk   = api.NewAlertChannel(d.Get("name").(string),
 			api.SplunkHecAlertChannelType,
 			api.SplunkHecDataV2{
@@ -164,10 +164,10 @@ func resourceLaceworkAlertChannelSplunkCreate(d *schema.ResourceData, meta inter
 }
 
 func resourceLaceworkAlertChannelSplunkRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	var response api.SplunkHecAlertChannelResponseV2
 
 	log.Printf("[INFO] Reading %s integration with guid %s\n", api.SplunkHecAlertChannelType, d.Id())
-	response, err := lacework.V2.AlertChannels.GetSplunkHec(d.Id())
+	err := getAlertChannel(meta, d.Id(), &response)
 	if err != nil {
 		return resourceNotFound(d, err)
 	}
@@ -199,7 +199,7 @@ func resourceLaceworkAlertChannelSplunkRead(d *schema.ResourceData, meta interfa
 
 func resourceLaceworkAlertChannelSplunkUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		splunk   = api.NewAlertChannel(d.Get("name").(string),
 			api.SplunkHecAlertChannelType,
 			api.SplunkHecDataV2{
@@ -250,7 +250,7 @@ func resourceLaceworkAlertChannelSplunkUpdate(d *schema.ResourceData, meta inter
 }
 
 func resourceLaceworkAlertChannelSplunkDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s integration with guid %s\n", api.SplunkHecAlertChannelType, d.Id())
 	err := lacework.V2.AlertChannels.Delete(d.Id())
@@ -0,0 +1,28 @@
+package lacework
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateAlertChannelTypeData(t *testing.T) {
+	assert.NoError(t, validateAlertChannelTypeData("EmailUser",
+		`{"channelProps": {"recipients": ["foo@example.com"]}}`))
+	assert.NoError(t, validateAlertChannelTypeData("SlackChannel",
+		`{"slackUrl": "https://hooks.slack.com/services/ABC"}`))
+	assert.NoError(t, validateAlertChannelTypeData("NewChannelType",
+		`{"anything": ["goes"]}`), "unknown types should be passed through")
+
+	err := validateAlertChannelTypeData("EmailUser", `{"recipients": ["foo@example.com"]}`)
+	if assert.Error(t, err) {
+		assert.Equal(t,
+			`invalid data for alert channel type EmailUser: json: unknown field "recipients"`,
+			err.Error())
+	}
+
+	err = validateAlertChannelTypeData("SlackChannel", `{"slackUrl": 123}`)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "invalid data for alert channel type SlackChannel")
+	}
+}
@@ -67,7 +67,7 @@ func resourceLaceworkAlertChannelWebhook() *schema.Resource {
 
 func resourceLaceworkAlertChannelWebhookCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		webhook  = api.NewAlertChannel(d.Get("name").(string),
 			api.WebhookAlertChannelType,
 			api.WebhookDataV2{
@@ -109,10 +109,10 @@ func resourceLaceworkAlertChannelWebhookCreate(d *schema.ResourceData, meta inte
 }
 
 func resourceLaceworkAlertChannelWebhookRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	var response api.WebhookAlertChannelResponseV2
 
 	log.Printf("[INFO] Reading %s integration with guid: %v\n", api.WebhookAlertChannelType, d.Id())
-	response, err := lacework.V2.AlertChannels.GetWebhook(d.Id())
+	err := getAlertChannel(meta, d.Id(), &response)
 	if err != nil {
 		return resourceNotFound(d, err)
 	}
@@ -133,7 +133,7 @@ func resourceLaceworkAlertChannelWebhookRead(d *schema.ResourceData, meta interf
 
 func resourceLaceworkAlertChannelWebhookUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		webhook  = api.NewAlertChannel(d.Get("name").(string),
 			api.WebhookAlertChannelType,
 			api.WebhookDataV2{
@@ -177,7 +177,7 @@ func resourceLaceworkAlertChannelWebhookUpdate(d *schema.ResourceData, meta inte
 }
 
 func resourceLaceworkAlertChannelWebhookDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s integration with guid %s\n", api.WebhookAlertChannelType, d.Id())
 	err := lacework.V2.AlertChannels.Delete(d.Id())
@@ -9,8 +9,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/lacework/go-sdk/api"
-	"golang.org/x/text/cases"
-	"golang.org/x/text/language"
 )
 
 func resourceLaceworkAlertRule() *schema.Resource {
@@ -59,22 +57,9 @@ func resourceLaceworkAlertRule() *schema.Resource {
 				Description: "List of severities for the alert rule. Valid severities are:" +
 					" Critical, High, Medium, Low, Info",
 				Elem: &schema.Schema{
-					Type: schema.TypeString,
-					StateFunc: func(val interface{}) string {
-						return strings.TrimSpace(cases.Title(language.English).String(strings.ToLower(val.(string))))
-					},
-					ValidateFunc: func(value interface{}, key string) ([]string, []error) {
-						switch strings.ToLower(value.(string)) {
-						case "critical", "high", "medium", "low", "info":
-							return nil, nil
-						default:
-							return nil, []error{
-								fmt.Errorf(
-									"%s: can only be 'Critical', 'High', 'Medium', 'Low', 'Info'", key,
-								),
-							}
-						}
-					},
+					Type:             schema.TypeString,
+					StateFunc:        severityTitleStateFunc,
+					ValidateDiagFunc: ValidSeverity(),
 				},
 			},
 			"resource_groups": {
@@ -176,7 +161,7 @@ func resourceLaceworkAlertRuleCreate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	var (
-		lacework        = meta.(*api.Client)
+		lacework        = meta.(*providerMeta).client
 		resourceGroups  = d.Get("resource_groups").(*schema.Set).List()
 		alertCategories = d.Get("alert_categories").(*schema.Set).List()
 		alertSources    = d.Get("alert_sources").(*schema.Set).List()
@@ -218,7 +203,7 @@ func resourceLaceworkAlertRuleCreate(d *schema.ResourceData, meta interface{}) e
 
 func resourceLaceworkAlertRuleRead(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		response api.AlertRuleResponse
 	)
 
@@ -265,7 +250,7 @@ func resourceLaceworkAlertRuleUpdate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	var (
-		lacework        = meta.(*api.Client)
+		lacework        = meta.(*providerMeta).client
 		resourceGroups  = d.Get("resource_groups").(*schema.Set).List()
 		alertCategories = d.Get("alert_categories").(*schema.Set).List()
 		alertSources    = d.Get("alert_sources").(*schema.Set).List()
@@ -310,7 +295,7 @@ func resourceLaceworkAlertRuleUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceLaceworkAlertRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting alert rule with guid %s\n", d.Id())
 	err := lacework.V2.AlertRules.Delete(d.Id())
@@ -324,7 +309,7 @@ func resourceLaceworkAlertRuleDelete(d *schema.ResourceData, meta interface{}) e
 
 func importLaceworkAlertRule(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	var response api.AlertRuleResponse
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Importing Lacework Alert Rule with guid: %s\n", d.Id())
 
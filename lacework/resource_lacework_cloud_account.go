@@ -0,0 +1,210 @@
+package lacework
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/lacework/go-sdk/api"
+)
+
+func resourceLaceworkCloudAccount() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLaceworkCloudAccountCreate,
+		Read:   resourceLaceworkCloudAccountRead,
+		Update: resourceLaceworkCloudAccountUpdate,
+		Delete: resourceLaceworkCloudAccountDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "The Cloud Account integration type, for example AwsCfg or GcpAlPubSub",
+			},
+			"data": integrationDataSchema(
+				"The JSON encoded data of the Cloud Account integration, as documented by the Lacework APIv2",
+			),
+			"intg_guid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"retries": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     5,
+				Description: "The number of attempts to create the external integration.",
+			},
+			"created_or_updated_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_or_updated_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"org_level": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// buildCloudAccountRaw returns a Cloud Account integration of any type, including the
+// types that the Lacework Go SDK doesn't know about, since the data is passed as is
+func buildCloudAccountRaw(d *schema.ResourceData) (api.CloudAccountRaw, error) {
+	data, err := expandIntegrationData(d)
+	if err != nil {
+		return api.CloudAccountRaw{}, err
+	}
+
+	cloudType := d.Get("type").(string)
+	if _, known := api.FindCloudAccountType(cloudType); !known {
+		log.Printf("[INFO] Cloud Account type %s is not known by the provider, using data as is\n", cloudType)
+	}
+
+	cloudAccount := api.NewCloudAccount(d.Get("name").(string), api.NoneCloudAccount, data)
+	cloudAccount.Type = cloudType
+	if !d.Get("enabled").(bool) {
+		cloudAccount.Enabled = 0
+	}
+	return cloudAccount, nil
+}
+
+func setCloudAccountCommon(d *schema.ResourceData, cloudAccount api.CloudAccountRaw) {
+	d.Set("name", cloudAccount.Name)
+	d.Set("intg_guid", cloudAccount.IntgGuid)
+	d.Set("enabled", cloudAccount.Enabled == 1)
+	d.Set("created_or_updated_time", cloudAccount.CreatedOrUpdatedTime)
+	d.Set("created_or_updated_by", cloudAccount.CreatedOrUpdatedBy)
+	d.Set("type_name", cloudAccount.Type)
+	d.Set("org_level", cloudAccount.IsOrg == 1)
+}
+
+func resourceLaceworkCloudAccountCreate(d *schema.ResourceData, meta interface{}) error {
+	var (
+		lacework = meta.(*providerMeta).client
+		retries  = d.Get("retries").(int)
+	)
+
+	cloudAccount, err := buildCloudAccountRaw(d)
+	if err != nil {
+		return err
+	}
+
+	return retry.RetryContext(context.Background(), d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
+		retries--
+		log.Printf("[INFO] Creating %s integration\n", cloudAccount.Type)
+		response, err := lacework.V2.CloudAccounts.Create(cloudAccount)
+		if err != nil {
+			if retries <= 0 {
+				return retry.NonRetryableError(
+					fmt.Errorf("Error creating %s integration: %s", cloudAccount.Type, err),
+				)
+			}
+			log.Printf(
+				"[INFO] Unable to create %s integration. (retrying %d more time(s))\n%s\n",
+				cloudAccount.Type, retries, err,
+			)
+			return retry.RetryableError(fmt.Errorf(
+				"Unable to create %s integration (retrying %d more time(s))",
+				cloudAccount.Type, retries,
+			))
+		}
+
+		integration := response.Data
+		d.SetId(integration.IntgGuid)
+		setCloudAccountCommon(d, integration)
+
+		log.Printf("[INFO] Created %s integration with guid: %v\n",
+			integration.Type, integration.IntgGuid)
+		return nil
+	})
+}
+
+func resourceLaceworkCloudAccountRead(d *schema.ResourceData, meta interface{}) error {
+	var response api.CloudAccountResponse
+
+	log.Printf("[INFO] Reading Cloud Account integration with guid: %v\n", d.Id())
+	err := getCloudAccount(meta, d.Id(), &response)
+	if err != nil {
+		return resourceNotFound(d, err)
+	}
+
+	cloudAccount := response.Data
+	if cloudAccount.IntgGuid == d.Id() {
+		setCloudAccountCommon(d, cloudAccount)
+		d.Set("type", cloudAccount.Type)
+		if err := setIntegrationData(d, cloudAccount.Data); err != nil {
+			return err
+		}
+
+		log.Printf("[INFO] Read %s integration with guid: %v\n",
+			cloudAccount.Type, cloudAccount.IntgGuid)
+		return nil
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceLaceworkCloudAccountUpdate(d *schema.ResourceData, meta interface{}) error {
+	var (
+		lacework = meta.(*providerMeta).client
+		response api.CloudAccountResponse
+	)
+
+	cloudAccount, err := buildCloudAccountRaw(d)
+	if err != nil {
+		return err
+	}
+
+	cloudAccount.IntgGuid = d.Id()
+
+	log.Printf("[INFO] Updating %s integration with guid: %v\n", cloudAccount.Type, d.Id())
+	err = lacework.RequestEncoderDecoder("PATCH",
+		fmt.Sprintf("v2/CloudAccounts/%s", d.Id()), cloudAccount, &response)
+	if err != nil {
+		return err
+	}
+
+	setCloudAccountCommon(d, response.Data)
+
+	log.Printf("[INFO] Updated %s integration with guid: %v\n", cloudAccount.Type, d.Id())
+	return nil
+}
+
+func resourceLaceworkCloudAccountDelete(d *schema.ResourceData, meta interface{}) error {
+	lacework := meta.(*providerMeta).client
+
+	log.Printf("[INFO] Deleting %s integration with guid: %v\n", d.Get("type").(string), d.Id())
+	err := lacework.V2.CloudAccounts.Delete(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleted %s integration with guid: %v\n", d.Get("type").(string), d.Id())
+	return nil
+}
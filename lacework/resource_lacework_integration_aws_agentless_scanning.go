@@ -136,7 +136,7 @@ var awsAgentlessScanningIntegrationSchema = map[string]*schema.Schema{
 
 func resourceLaceworkIntegrationAwsAgentlessScanningCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		retries  = d.Get("retries").(int)
 	)
 
@@ -208,10 +208,10 @@ func resourceLaceworkIntegrationAwsAgentlessScanningCreate(d *schema.ResourceDat
 }
 
 func resourceLaceworkIntegrationAwsAgentlessScanningRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	var response api.AwsSidekickResponse
 
 	log.Printf("[INFO] Reading %s cloud account integration with guid: %v\n", api.AwsSidekickCloudAccount.String(), d.Id())
-	response, err := lacework.V2.CloudAccounts.GetAwsSidekick(d.Id())
+	err := getCloudAccount(meta, d.Id(), &response)
 	if err != nil {
 		return resourceNotFound(d, err)
 	}
@@ -244,7 +244,7 @@ func resourceLaceworkIntegrationAwsAgentlessScanningRead(d *schema.ResourceData,
 
 func resourceLaceworkIntegrationAwsAgentlessScanningUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 	)
 
 	awsAgentlessScanningData := api.AwsSidekickData{
@@ -296,7 +296,7 @@ func resourceLaceworkIntegrationAwsAgentlessScanningUpdate(d *schema.ResourceDat
 }
 
 func resourceLaceworkIntegrationAwsAgentlessScanningDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s cloud account integration with guid: %v\n", api.AwsSidekickCloudAccount.String(), d.Id())
 	err := lacework.V2.CloudAccounts.Delete(d.Id())
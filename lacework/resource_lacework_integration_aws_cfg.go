@@ -81,7 +81,7 @@ func resourceLaceworkIntegrationAwsCfg() *schema.Resource {
 
 func resourceLaceworkIntegrationAwsCfgCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		retries  = d.Get("retries").(int)
 		aws      = api.NewCloudAccount(d.Get("name").(string),
 			api.AwsCfgCloudAccount,
@@ -136,11 +136,11 @@ func resourceLaceworkIntegrationAwsCfgCreate(d *schema.ResourceData, meta interf
 }
 
 func resourceLaceworkIntegrationAwsCfgRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	var response api.AwsCfgIntegrationResponse
 
 	log.Printf("[INFO] Reading %s integration with guid: %v\n",
 		api.AwsCfgCloudAccount.String(), d.Id())
-	response, err := lacework.V2.CloudAccounts.GetAwsCfg(d.Id())
+	err := getCloudAccount(meta, d.Id(), &response)
 	if err != nil {
 		return resourceNotFound(d, err)
 	}
@@ -172,7 +172,7 @@ func resourceLaceworkIntegrationAwsCfgRead(d *schema.ResourceData, meta interfac
 
 func resourceLaceworkIntegrationAwsCfgUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		aws      = api.NewCloudAccount(d.Get("name").(string),
 			api.AwsCfgCloudAccount,
 			api.AwsCfgData{
@@ -211,7 +211,7 @@ func resourceLaceworkIntegrationAwsCfgUpdate(d *schema.ResourceData, meta interf
 }
 
 func resourceLaceworkIntegrationAwsCfgDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s integration with guid: %v\n",
 		api.AwsCfgCloudAccount.String(), d.Id())
@@ -124,7 +124,7 @@ var awsCloudTrailIntegrationSchema = map[string]*schema.Schema{
 
 func resourceLaceworkIntegrationAwsCloudTrailCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework     = meta.(*api.Client)
+		lacework     = meta.(*providerMeta).client
 		retries      = d.Get("retries").(int)
 		awsCtSqsData = api.AwsCtSqsData{
 			QueueUrl: d.Get("queue_url").(string),
@@ -193,10 +193,10 @@ func resourceLaceworkIntegrationAwsCloudTrailCreate(d *schema.ResourceData, meta
 }
 
 func resourceLaceworkIntegrationAwsCloudTrailRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	var response api.AwsCtSqsIntegrationResponse
 
 	log.Printf("[INFO] Reading %s cloud account integration with guid: %v\n", api.AwsCtSqsCloudAccount.String(), d.Id())
-	response, err := lacework.V2.CloudAccounts.GetAwsCtSqs(d.Id())
+	err := getCloudAccount(meta, d.Id(), &response)
 	if err != nil {
 		return resourceNotFound(d, err)
 	}
@@ -251,7 +251,7 @@ func resourceLaceworkIntegrationAwsCloudTrailRead(d *schema.ResourceData, meta i
 
 func resourceLaceworkIntegrationAwsCloudTrailUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework     = meta.(*api.Client)
+		lacework     = meta.(*providerMeta).client
 		awsCtSqsData = api.AwsCtSqsData{
 			QueueUrl: d.Get("queue_url").(string),
 			Credentials: api.AwsCtSqsCredentials{
@@ -303,7 +303,7 @@ func resourceLaceworkIntegrationAwsCloudTrailUpdate(d *schema.ResourceData, meta
 }
 
 func resourceLaceworkIntegrationAwsCloudTrailDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s cloud account integration with guid: %v\n", api.AwsCtSqsCloudAccount.String(), d.Id())
 	err := lacework.V2.CloudAccounts.Delete(d.Id())
@@ -92,7 +92,7 @@ var awsEksAuditLogIntegrationSchema = map[string]*schema.Schema{
 
 func resourceLaceworkIntegrationAwsEksAuditLogCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework           = meta.(*api.Client)
+		lacework           = meta.(*providerMeta).client
 		retries            = d.Get("retries").(int)
 		awsEksAuditLogData = api.AwsEksAuditData{
 			SnsArn:      d.Get("sns_arn").(string),
@@ -152,10 +152,10 @@ func resourceLaceworkIntegrationAwsEksAuditLogCreate(d *schema.ResourceData, met
 }
 
 func resourceLaceworkIntegrationAwsEksAuditLogRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	var response api.AwsEksAuditIntegrationResponse
 
 	log.Printf("[INFO] Reading %s cloud account integration with guid: %v\n", api.AwsEksAuditCloudAccount.String(), d.Id())
-	response, err := lacework.V2.CloudAccounts.GetAwsEksAudit(d.Id())
+	err := getCloudAccount(meta, d.Id(), &response)
 	if err != nil {
 		return resourceNotFound(d, err)
 	}
@@ -192,7 +192,7 @@ func resourceLaceworkIntegrationAwsEksAuditLogRead(d *schema.ResourceData, meta
 
 func resourceLaceworkIntegrationAwsEksAuditLogUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework           = meta.(*api.Client)
+		lacework           = meta.(*providerMeta).client
 		awsEksAuditLogData = api.AwsEksAuditData{
 			SnsArn:      d.Get("sns_arn").(string),
 			S3BucketArn: d.Get("s3_bucket_arn").(string),
@@ -236,7 +236,7 @@ func resourceLaceworkIntegrationAwsEksAuditLogUpdate(d *schema.ResourceData, met
 }
 
 func resourceLaceworkIntegrationAwsEksAuditLogDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s cloud account integration with guid: %v\n", api.AwsEksAuditCloudAccount.String(), d.Id())
 	err := lacework.V2.CloudAccounts.Delete(d.Id())
@@ -89,7 +89,7 @@ func resourceLaceworkIntegrationAwsGovCloudCfg() *schema.Resource {
 
 func resourceLaceworkIntegrationAwsGovCloudCfgCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		retries  = d.Get("retries").(int)
 		aws      = api.NewCloudAccount(d.Get("name").(string),
 			api.AwsUsGovCfgCloudAccount,
@@ -146,11 +146,11 @@ func resourceLaceworkIntegrationAwsGovCloudCfgCreate(d *schema.ResourceData, met
 }
 
 func resourceLaceworkIntegrationAwsGovCloudCfgRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	var response api.AwsUsGovCfgIntegrationResponse
 
 	log.Printf("[INFO] Reading %s integration with guid: %v\n",
 		api.AwsUsGovCfgCloudAccount.String(), d.Id())
-	response, err := lacework.V2.CloudAccounts.GetAwsUsGovCfg(d.Id())
+	err := getCloudAccount(meta, d.Id(), &response)
 	if err != nil {
 		return resourceNotFound(d, err)
 	}
@@ -183,7 +183,7 @@ func resourceLaceworkIntegrationAwsGovCloudCfgRead(d *schema.ResourceData, meta
 
 func resourceLaceworkIntegrationAwsGovCloudCfgUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		aws      = api.NewCloudAccount(d.Get("name").(string),
 			api.AwsCfgCloudAccount,
 			api.AwsUsGovCfgData{
@@ -224,7 +224,7 @@ func resourceLaceworkIntegrationAwsGovCloudCfgUpdate(d *schema.ResourceData, met
 }
 
 func resourceLaceworkIntegrationAwsGovCloudCfgDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s integration with guid: %v\n",
 		api.AwsUsGovCfgCloudAccount.String(), d.Id())
@@ -94,7 +94,7 @@ func resourceLaceworkIntegrationAwsGovCloudCT() *schema.Resource {
 
 func resourceLaceworkIntegrationAwsGovCloudCTCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		retries  = d.Get("retries").(int)
 		aws      = api.NewCloudAccount(d.Get("name").(string),
 			api.AwsUsGovCtSqsCloudAccount,
@@ -152,11 +152,11 @@ func resourceLaceworkIntegrationAwsGovCloudCTCreate(d *schema.ResourceData, meta
 }
 
 func resourceLaceworkIntegrationAwsGovCloudCTRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	var response api.AwsUsGovCtSqsIntegrationResponse
 
 	log.Printf("[INFO] Reading %s integration with guid: %v\n",
 		api.AwsUsGovCtSqsCloudAccount.String(), d.Id())
-	response, err := lacework.V2.CloudAccounts.GetAwsUsGovCtSqs(d.Id())
+	err := getCloudAccount(meta, d.Id(), &response)
 	if err != nil {
 		return resourceNotFound(d, err)
 	}
@@ -190,7 +190,7 @@ func resourceLaceworkIntegrationAwsGovCloudCTRead(d *schema.ResourceData, meta i
 
 func resourceLaceworkIntegrationAwsGovCloudCTUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		aws      = api.NewCloudAccount(d.Get("name").(string),
 			api.AwsUsGovCtSqsCloudAccount,
 			api.AwsUsGovCtSqsData{
@@ -232,7 +232,7 @@ func resourceLaceworkIntegrationAwsGovCloudCTUpdate(d *schema.ResourceData, meta
 }
 
 func resourceLaceworkIntegrationAwsGovCloudCTDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s integration with guid: %v\n",
 		api.AwsUsGovCtSqsCloudAccount.String(), d.Id())
@@ -0,0 +1,425 @@
+package lacework
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/lacework/go-sdk/api"
+)
+
+var (
+	awsIamRoleArnRegex = regexp.MustCompile(`^arn:aws[a-z-]*:iam::(\d{12}):role/.+$`)
+	awsAccountIDRegex  = regexp.MustCompile(`^\d{12}$`)
+	awsPartitions      = []string{"aws", "aws-cn", "aws-us-gov"}
+)
+
+func resourceLaceworkIntegrationAwsOrgCfg() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLaceworkIntegrationAwsOrgCfgCreate,
+		Read:          resourceLaceworkIntegrationAwsOrgCfgRead,
+		Update:        resourceLaceworkIntegrationAwsOrgCfgUpdate,
+		Delete:        resourceLaceworkIntegrationAwsOrgCfgDelete,
+
+		// the integrations of new member accounts are created during both creates and updates
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name prefix of the AWS Config integrations, each integration is named '<name> <account_id>'",
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"retries": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     5,
+				Description: "The number of attempts to create each external integration.",
+			},
+			"external_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The external ID of the IAM roles of the member accounts",
+			},
+			"role_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringMatch(awsIamRoleArnRegex,
+						"must be the ARN of an IAM role, like arn:aws:iam::123456789012:role/lacework"),
+				},
+				ExactlyOneOf: []string{"role_arns", "role_name"},
+				Description:  "The ARNs of the IAM roles of the member accounts",
+			},
+			"role_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"account_ids"},
+				Description:  "The name of the IAM role deployed to every member account, used with account_ids",
+			},
+			"partition": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "aws",
+				ValidateFunc: validation.StringInSlice(awsPartitions, false),
+				Description:  "The AWS partition of the member accounts, used with role_name to build the ARNs of the IAM roles",
+			},
+			"account_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(awsAccountIDRegex, "must be a 12 digit AWS account ID"),
+				},
+				RequiredWith: []string{"role_name"},
+				Description:  "The IDs of the member accounts, used with role_name",
+			},
+			"intg_guids": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The GUIDs of the AWS Config integrations keyed by AWS account ID",
+			},
+		},
+	}
+}
+
+// resourceLaceworkIntegrationAwsOrgCfgCreate creates the integrations of all member accounts. An error
+// would taint the resource and the next apply would recreate every integration, so the accounts whose
+// integration fails to be created, for example because their IAM role has not propagated yet, are
+// reported as warnings and the next plan creates them from the integrations found by Read
+func resourceLaceworkIntegrationAwsOrgCfgCreate(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	memberRoles, err := getAwsOrgCfgMemberRoles(
+		castStringSlice(d.Get("role_arns").(*schema.Set).List()),
+		castStringSlice(d.Get("account_ids").(*schema.Set).List()),
+		d.Get("role_name").(string),
+		d.Get("partition").(string),
+	)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var (
+		diags     diag.Diagnostics
+		intgGuids = make(map[string]string, len(memberRoles))
+	)
+	for _, accountID := range sortedKeys(memberRoles) {
+		intgGuid, err := createAwsOrgCfgMember(d, meta, accountID, memberRoles[accountID],
+			d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary: fmt.Sprintf("Unable to create the %s integration of account %s",
+					api.AwsCfgCloudAccount.String(), accountID),
+				Detail: fmt.Sprintf("%s\n\nThe next plan creates the missing integration.", err),
+			})
+			continue
+		}
+		intgGuids[accountID] = intgGuid
+	}
+
+	// nothing was created, so there is nothing to recreate
+	if len(intgGuids) == 0 && len(diags) > 0 {
+		for i := range diags {
+			diags[i].Severity = diag.Error
+		}
+		return diags
+	}
+
+	d.SetId(time.Now().UTC().String())
+	d.Set("intg_guids", intgGuids)
+
+	log.Printf("[INFO] Created %d %s integrations\n", len(intgGuids), api.AwsCfgCloudAccount.String())
+	return diags
+}
+
+func resourceLaceworkIntegrationAwsOrgCfgRead(d *schema.ResourceData, meta interface{}) error {
+	var (
+		intgGuids    = make(map[string]string)
+		roleArns     = make([]string, 0)
+		accountIDs   = make([]string, 0)
+		currentGuids = castStringMap(d.Get("intg_guids").(map[string]interface{}))
+	)
+
+	for _, accountID := range sortedKeys(currentGuids) {
+		var response api.AwsCfgIntegrationResponse
+
+		log.Printf("[INFO] Reading %s integration with guid: %v\n",
+			api.AwsCfgCloudAccount.String(), currentGuids[accountID])
+		err := getCloudAccount(meta, currentGuids[accountID], &response)
+		if err != nil {
+			if notFound(err) {
+				log.Printf("[WARN] %s integration of account %s not found, removing from state\n",
+					api.AwsCfgCloudAccount.String(), accountID)
+				continue
+			}
+			return err
+		}
+
+		// the settings shared by all integrations are read from the first member account
+		if len(intgGuids) == 0 {
+			d.Set("name", awsOrgCfgNamePrefix(response.Data.Name, accountID))
+			d.Set("enabled", response.Data.Enabled == 1)
+			d.Set("external_id", response.Data.Data.Credentials.ExternalID)
+		}
+
+		intgGuids[accountID] = response.Data.IntgGuid
+		roleArns = append(roleArns, response.Data.Data.Credentials.RoleArn)
+		accountIDs = append(accountIDs, accountID)
+	}
+
+	d.Set("intg_guids", intgGuids)
+
+	// the member accounts are set from the existing integrations, so that a plan
+	// creates the integrations that were deleted outside of Terraform
+	if d.Get("role_name").(string) != "" {
+		d.Set("account_ids", accountIDs)
+	} else {
+		d.Set("role_arns", roleArns)
+	}
+
+	log.Printf("[INFO] Read %d %s integrations\n", len(intgGuids), api.AwsCfgCloudAccount.String())
+	return nil
+}
+
+func resourceLaceworkIntegrationAwsOrgCfgUpdate(d *schema.ResourceData, meta interface{}) error {
+	var (
+		lacework                   = meta.(*providerMeta).client
+		oldRoleArns, newRoleArns   = d.GetChange("role_arns")
+		oldAccounts, newAccounts   = d.GetChange("account_ids")
+		oldRoleName, newRoleName   = d.GetChange("role_name")
+		oldPartition, newPartition = d.GetChange("partition")
+		intgGuids                  = castStringMap(d.Get("intg_guids").(map[string]interface{}))
+		settingsChanged            = d.HasChanges("name", "enabled", "external_id")
+	)
+
+	memberRoles, err := getAwsOrgCfgMemberRoles(
+		castStringSlice(newRoleArns.(*schema.Set).List()),
+		castStringSlice(newAccounts.(*schema.Set).List()),
+		newRoleName.(string),
+		newPartition.(string),
+	)
+	if err != nil {
+		return err
+	}
+
+	// the previous roles are only used to detect the member accounts whose role changed,
+	// states created before the partition argument existed used the commercial partition
+	if oldPartition.(string) == "" {
+		oldPartition = "aws"
+	}
+	oldMemberRoles, err := getAwsOrgCfgMemberRoles(
+		castStringSlice(oldRoleArns.(*schema.Set).List()),
+		castStringSlice(oldAccounts.(*schema.Set).List()),
+		oldRoleName.(string),
+		oldPartition.(string),
+	)
+	if err != nil {
+		oldMemberRoles = map[string]string{}
+	}
+
+	plan := planAwsOrgCfgMembers(intgGuids, oldMemberRoles, memberRoles, settingsChanged)
+	defer func() { d.Set("intg_guids", intgGuids) }()
+
+	for _, accountID := range plan.Delete {
+		log.Printf("[INFO] Deleting %s integration of account %s with guid: %v\n",
+			api.AwsCfgCloudAccount.String(), accountID, intgGuids[accountID])
+		err := lacework.V2.CloudAccounts.Delete(intgGuids[accountID])
+		if err != nil && !notFound(err) {
+			return err
+		}
+		delete(intgGuids, accountID)
+	}
+
+	for _, accountID := range plan.Create {
+		newGuid, err := createAwsOrgCfgMember(d, meta, accountID, memberRoles[accountID],
+			d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return err
+		}
+		intgGuids[accountID] = newGuid
+	}
+
+	for _, accountID := range plan.Update {
+		aws := newAwsOrgCfgMember(d, accountID, memberRoles[accountID])
+		aws.IntgGuid = intgGuids[accountID]
+
+		log.Printf("[INFO] Updating %s integration of account %s with guid: %v\n",
+			api.AwsCfgCloudAccount.String(), accountID, aws.IntgGuid)
+		if _, err := lacework.V2.CloudAccounts.UpdateAwsCfg(aws); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[INFO] Updated %d %s integrations\n", len(intgGuids), api.AwsCfgCloudAccount.String())
+	return nil
+}
+
+func resourceLaceworkIntegrationAwsOrgCfgDelete(d *schema.ResourceData, meta interface{}) error {
+	var (
+		lacework  = meta.(*providerMeta).client
+		intgGuids = castStringMap(d.Get("intg_guids").(map[string]interface{}))
+	)
+
+	for _, accountID := range sortedKeys(intgGuids) {
+		log.Printf("[INFO] Deleting %s integration of account %s with guid: %v\n",
+			api.AwsCfgCloudAccount.String(), accountID, intgGuids[accountID])
+		err := lacework.V2.CloudAccounts.Delete(intgGuids[accountID])
+		if err != nil && !notFound(err) {
+			return err
+		}
+	}
+
+	log.Printf("[INFO] Deleted %d %s integrations\n", len(intgGuids), api.AwsCfgCloudAccount.String())
+	return nil
+}
+
+func newAwsOrgCfgMember(d *schema.ResourceData, accountID, roleArn string) api.CloudAccountRaw {
+	aws := api.NewCloudAccount(fmt.Sprintf("%s %s", d.Get("name").(string), accountID),
+		api.AwsCfgCloudAccount,
+		api.AwsCfgData{
+			Credentials: api.AwsCfgCredentials{
+				RoleArn:    roleArn,
+				ExternalID: d.Get("external_id").(string),
+			},
+		})
+
+	if !d.Get("enabled").(bool) {
+		aws.Enabled = 0
+	}
+	return aws
+}
+
+// awsOrgCfgMembersPlan is the sorted list of member accounts whose integration has to
+// be deleted, created or updated
+type awsOrgCfgMembersPlan struct {
+	Delete []string
+	Create []string
+	Update []string
+}
+
+// planAwsOrgCfgMembers returns the changes to apply to the integrations of the member accounts,
+// the integrations of removed accounts are deleted, the ones of new accounts are created, and
+// the existing ones are only updated if their role or the shared settings changed
+func planAwsOrgCfgMembers(intgGuids, oldMemberRoles, memberRoles map[string]string,
+	settingsChanged bool) awsOrgCfgMembersPlan {
+	plan := awsOrgCfgMembersPlan{Delete: []string{}, Create: []string{}, Update: []string{}}
+
+	for _, accountID := range sortedKeys(intgGuids) {
+		if _, ok := memberRoles[accountID]; !ok {
+			plan.Delete = append(plan.Delete, accountID)
+		}
+	}
+
+	for _, accountID := range sortedKeys(memberRoles) {
+		if _, ok := intgGuids[accountID]; !ok {
+			plan.Create = append(plan.Create, accountID)
+			continue
+		}
+		if settingsChanged || oldMemberRoles[accountID] != memberRoles[accountID] {
+			plan.Update = append(plan.Update, accountID)
+		}
+	}
+	return plan
+}
+
+// awsOrgCfgNamePrefix returns the name prefix of the integration of a member account
+func awsOrgCfgNamePrefix(name, accountID string) string {
+	return strings.TrimSuffix(name, " "+accountID)
+}
+
+// createAwsOrgCfgMember creates the AWS Config integration of a member account and returns its
+// guid, the creation is retried until the timeout of the operation since IAM roles deployed by
+// a StackSet take a while to propagate
+func createAwsOrgCfgMember(d *schema.ResourceData, meta interface{}, accountID, roleArn string,
+	timeout time.Duration) (string, error) {
+	var (
+		lacework = meta.(*providerMeta).client
+		retries  = d.Get("retries").(int)
+		aws      = newAwsOrgCfgMember(d, accountID, roleArn)
+		intgGuid string
+	)
+
+	err := retry.RetryContext(context.Background(), timeout, func() *retry.RetryError {
+		retries--
+		log.Printf("[INFO] Creating %s integration of account %s\n", api.AwsCfgCloudAccount.String(), accountID)
+		response, err := lacework.V2.CloudAccounts.Create(aws)
+		if err != nil {
+			if retries <= 0 {
+				return retry.NonRetryableError(
+					fmt.Errorf("Error creating %s integration of account %s: %s",
+						api.AwsCfgCloudAccount.String(), accountID, err,
+					))
+			}
+			log.Printf(
+				"[INFO] Unable to create %s integration of account %s. (retrying %d more time(s))\n%s\n",
+				api.AwsCfgCloudAccount.String(), accountID, retries, err,
+			)
+			return retry.RetryableError(fmt.Errorf(
+				"Unable to create %s integration of account %s (retrying %d more time(s))",
+				api.AwsCfgCloudAccount.String(), accountID, retries,
+			))
+		}
+
+		intgGuid = response.Data.IntgGuid
+		log.Printf("[INFO] Created %s integration of account %s with guid: %v\n",
+			api.AwsCfgCloudAccount.String(), accountID, intgGuid)
+		return nil
+	})
+	return intgGuid, err
+}
+
+// getAwsOrgCfgMemberRoles returns the IAM role ARNs of the member accounts keyed by AWS account
+// ID, either from the list of role ARNs or from the account IDs, the role name and the partition
+func getAwsOrgCfgMemberRoles(roleArns, accountIDs []string, roleName, partition string) (map[string]string, error) {
+	memberRoles := make(map[string]string)
+
+	if roleName != "" {
+		for _, accountID := range accountIDs {
+			memberRoles[accountID] = fmt.Sprintf("arn:%s:iam::%s:role/%s", partition, accountID, roleName)
+		}
+		return memberRoles, nil
+	}
+
+	for _, roleArn := range roleArns {
+		match := awsIamRoleArnRegex.FindStringSubmatch(roleArn)
+		if match == nil {
+			return nil, fmt.Errorf("invalid IAM role ARN: %s", roleArn)
+		}
+
+		accountID := match[1]
+		if existing, ok := memberRoles[accountID]; ok {
+			return nil, fmt.Errorf("duplicate IAM roles for account %s: %s and %s", accountID, existing, roleArn)
+		}
+		memberRoles[accountID] = roleArn
+	}
+	return memberRoles, nil
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
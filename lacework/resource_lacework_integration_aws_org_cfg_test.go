@@ -0,0 +1,107 @@
+package lacework
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetAwsOrgCfgMemberRoles(t *testing.T) {
+	memberRoles, err := getAwsOrgCfgMemberRoles([]string{
+		"arn:aws:iam::123456789012:role/lacework",
+		"arn:aws-us-gov:iam::210987654321:role/path/lacework",
+	}, nil, "", "aws")
+	if assert.Nil(t, err) {
+		assert.Equal(t, map[string]string{
+			"123456789012": "arn:aws:iam::123456789012:role/lacework",
+			"210987654321": "arn:aws-us-gov:iam::210987654321:role/path/lacework",
+		}, memberRoles)
+	}
+
+	memberRoles, err = getAwsOrgCfgMemberRoles(nil, []string{"123456789012", "210987654321"}, "lacework-stackset", "aws")
+	if assert.Nil(t, err) {
+		assert.Equal(t, map[string]string{
+			"123456789012": "arn:aws:iam::123456789012:role/lacework-stackset",
+			"210987654321": "arn:aws:iam::210987654321:role/lacework-stackset",
+		}, memberRoles)
+	}
+
+	memberRoles, err = getAwsOrgCfgMemberRoles(nil, []string{"123456789012"}, "lacework-stackset", "aws-cn")
+	if assert.Nil(t, err) {
+		assert.Equal(t, map[string]string{
+			"123456789012": "arn:aws-cn:iam::123456789012:role/lacework-stackset",
+		}, memberRoles)
+	}
+
+	_, err = getAwsOrgCfgMemberRoles([]string{
+		"arn:aws:iam::123456789012:role/lacework",
+		"arn:aws:iam::123456789012:role/other",
+	}, nil, "", "aws")
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "duplicate IAM roles for account 123456789012")
+	}
+
+	_, err = getAwsOrgCfgMemberRoles([]string{"arn:aws:iam::123:user/lacework"}, nil, "", "aws")
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "invalid IAM role ARN: arn:aws:iam::123:user/lacework", err.Error())
+	}
+}
+
+func TestPlanAwsOrgCfgMembers(t *testing.T) {
+	var (
+		intgGuids = map[string]string{
+			"111111111111": "GUID_1",
+			"222222222222": "GUID_2",
+			"333333333333": "GUID_3",
+		}
+		oldMemberRoles = map[string]string{
+			"111111111111": "arn:aws:iam::111111111111:role/lacework",
+			"222222222222": "arn:aws:iam::222222222222:role/lacework",
+			"333333333333": "arn:aws:iam::333333333333:role/lacework",
+		}
+		memberRoles = map[string]string{
+			"111111111111": "arn:aws:iam::111111111111:role/lacework",
+			"222222222222": "arn:aws:iam::222222222222:role/renamed",
+			"444444444444": "arn:aws:iam::444444444444:role/lacework",
+		}
+	)
+
+	// only the member whose role changed is updated
+	assert.Equal(t, awsOrgCfgMembersPlan{
+		Delete: []string{"333333333333"},
+		Create: []string{"444444444444"},
+		Update: []string{"222222222222"},
+	}, planAwsOrgCfgMembers(intgGuids, oldMemberRoles, memberRoles, false))
+
+	// every remaining member is updated when the shared settings changed
+	assert.Equal(t, awsOrgCfgMembersPlan{
+		Delete: []string{"333333333333"},
+		Create: []string{"444444444444"},
+		Update: []string{"111111111111", "222222222222"},
+	}, planAwsOrgCfgMembers(intgGuids, oldMemberRoles, memberRoles, true))
+
+	// members whose integration was deleted outside of Terraform are created again
+	assert.Equal(t, awsOrgCfgMembersPlan{
+		Delete: []string{},
+		Create: []string{"222222222222"},
+		Update: []string{},
+	}, planAwsOrgCfgMembers(
+		map[string]string{"111111111111": "GUID_1"},
+		oldMemberRoles,
+		map[string]string{
+			"111111111111": "arn:aws:iam::111111111111:role/lacework",
+			"222222222222": "arn:aws:iam::222222222222:role/lacework",
+		},
+		false,
+	))
+}
+
+func TestAwsOrgCfgNamePrefix(t *testing.T) {
+	assert.Equal(t, "org config", awsOrgCfgNamePrefix("org config 123456789012", "123456789012"))
+	assert.Equal(t, "renamed", awsOrgCfgNamePrefix("renamed", "123456789012"))
+}
+
+func TestSortedKeys(t *testing.T) {
+	assert.Equal(t, []string{"a", "b", "c"}, sortedKeys(map[string]string{"c": "3", "a": "1", "b": "2"}))
+	assert.Empty(t, sortedKeys(map[string]string{}))
+}
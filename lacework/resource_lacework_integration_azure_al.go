@@ -77,6 +77,7 @@ func resourceLaceworkIntegrationAzureActivityLog() *schema.Resource {
 					},
 				},
 			},
+			"org_account_mappings": orgAccountMappingsSchema(azureOrgAccountMappings),
 			"created_or_updated_time": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -99,11 +100,10 @@ func resourceLaceworkIntegrationAzureActivityLog() *schema.Resource {
 
 func resourceLaceworkIntegrationAzureActivityLogCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
-		retries  = d.Get("retries").(int)
-		azure    = api.NewCloudAccount(d.Get("name").(string),
-			api.AzureAlSeqCloudAccount,
-			api.AzureAlSeqData{
+		lacework  = meta.(*providerMeta).client
+		retries   = d.Get("retries").(int)
+		azureData = azureAlSeqData{
+			AzureAlSeqData: api.AzureAlSeqData{
 				TenantID: d.Get("tenant_id").(string),
 				QueueUrl: d.Get("queue_url").(string),
 				Credentials: api.AzureAlSeqCredentials{
@@ -111,8 +111,23 @@ func resourceLaceworkIntegrationAzureActivityLogCreate(d *schema.ResourceData, m
 					ClientSecret: d.Get("credentials.0.client_secret").(string),
 				},
 			},
-		)
+		}
+	)
+
+	// verify if the user provided an account mapping
+	accountMapFile, err := getResourceOrgAccountMappingsFile(d, azureOrgAccountMappings)
+	if err != nil {
+		return err
+	}
+	if len(accountMapFile) != 0 {
+		azureData.EncodeAccountMappingFile(accountMapFile)
+	}
+
+	azure := api.NewCloudAccount(d.Get("name").(string),
+		api.AzureAlSeqCloudAccount,
+		azureData,
 	)
+
 	if !d.Get("enabled").(bool) {
 		azure.Enabled = 0
 	}
@@ -155,10 +170,10 @@ func resourceLaceworkIntegrationAzureActivityLogCreate(d *schema.ResourceData, m
 }
 
 func resourceLaceworkIntegrationAzureActivityLogRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	var response azureAlSeqIntegrationResponse
 
 	log.Printf("[INFO] Reading %s integration with guid: %v\n", api.AzureAlSeqCloudAccount.String(), d.Id())
-	response, err := lacework.V2.CloudAccounts.GetAzureAlSeq(d.Id())
+	err := getCloudAccount(meta, d.Id(), &response)
 	if err != nil {
 		return err
 	}
@@ -179,6 +194,14 @@ func resourceLaceworkIntegrationAzureActivityLogRead(d *schema.ResourceData, met
 		d.Set("queue_url", integration.Data.QueueUrl)
 		d.Set("tenant_id", integration.Data.TenantID)
 
+		accountMapFile, err := integration.Data.DecodeAccountMappingFile()
+		if err != nil {
+			return err
+		}
+		if err := setResourceOrgAccountMappingsFile(d, azureOrgAccountMappings, accountMapFile); err != nil {
+			return err
+		}
+
 		log.Printf("[INFO] Read %s integration with guid: %v\n", api.AzureAlSeqCloudAccount.String(), integration.IntgGuid)
 		return nil
 	}
@@ -189,10 +212,9 @@ func resourceLaceworkIntegrationAzureActivityLogRead(d *schema.ResourceData, met
 
 func resourceLaceworkIntegrationAzureActivityLogUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
-		azure    = api.NewCloudAccount(d.Get("name").(string),
-			api.AzureAlSeqCloudAccount,
-			api.AzureAlSeqData{
+		lacework  = meta.(*providerMeta).client
+		azureData = azureAlSeqData{
+			AzureAlSeqData: api.AzureAlSeqData{
 				TenantID: d.Get("tenant_id").(string),
 				QueueUrl: d.Get("queue_url").(string),
 				Credentials: api.AzureAlSeqCredentials{
@@ -200,7 +222,21 @@ func resourceLaceworkIntegrationAzureActivityLogUpdate(d *schema.ResourceData, m
 					ClientSecret: d.Get("credentials.0.client_secret").(string),
 				},
 			},
-		)
+		}
+	)
+
+	// verify if the user provided an account mapping
+	accountMapFile, err := getResourceOrgAccountMappingsFile(d, azureOrgAccountMappings)
+	if err != nil {
+		return err
+	}
+	if len(accountMapFile) != 0 {
+		azureData.EncodeAccountMappingFile(accountMapFile)
+	}
+
+	azure := api.NewCloudAccount(d.Get("name").(string),
+		api.AzureAlSeqCloudAccount,
+		azureData,
 	)
 
 	if !d.Get("enabled").(bool) {
@@ -229,7 +265,7 @@ func resourceLaceworkIntegrationAzureActivityLogUpdate(d *schema.ResourceData, m
 }
 
 func resourceLaceworkIntegrationAzureActivityLogDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s integration with guid: %v\n", api.AzureAlSeqCloudAccount.String(), d.Id())
 	err := lacework.V2.CloudAccounts.Delete(d.Id())
@@ -240,3 +276,19 @@ func resourceLaceworkIntegrationAzureActivityLogDelete(d *schema.ResourceData, m
 	log.Printf("[INFO] Deleted %s integration with guid: %v\n", api.AzureAlSeqCloudAccount.String(), d.Id())
 	return nil
 }
+
+// azureAlSeqData extends the data of Azure Activity Log integrations from the Go SDK
+// with the account mapping file of tenant level integrations
+type azureAlSeqData struct {
+	api.AzureAlSeqData
+	orgAccountMappingFile
+}
+
+type azureAlSeqIntegration struct {
+	api.AzureAlSeq
+	Data azureAlSeqData `json:"data"`
+}
+
+type azureAlSeqIntegrationResponse struct {
+	Data azureAlSeqIntegration `json:"data"`
+}
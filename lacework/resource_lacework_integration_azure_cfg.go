@@ -73,6 +73,7 @@ func resourceLaceworkIntegrationAzureCfg() *schema.Resource {
 					},
 				},
 			},
+			"org_account_mappings": orgAccountMappingsSchema(azureOrgAccountMappings),
 			"created_or_updated_time": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -95,18 +96,31 @@ func resourceLaceworkIntegrationAzureCfg() *schema.Resource {
 
 func resourceLaceworkIntegrationAzureCfgCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
-		retries  = d.Get("retries").(int)
-		azure    = api.NewCloudAccount(d.Get("name").(string),
-			api.AzureCfgCloudAccount,
-			api.AzureCfgData{
+		lacework  = meta.(*providerMeta).client
+		retries   = d.Get("retries").(int)
+		azureData = azureCfgData{
+			AzureCfgData: api.AzureCfgData{
 				TenantID: d.Get("tenant_id").(string),
 				Credentials: api.AzureCfgCredentials{
 					ClientID:     d.Get("credentials.0.client_id").(string),
 					ClientSecret: d.Get("credentials.0.client_secret").(string),
 				},
 			},
-		)
+		}
+	)
+
+	// verify if the user provided an account mapping
+	accountMapFile, err := getResourceOrgAccountMappingsFile(d, azureOrgAccountMappings)
+	if err != nil {
+		return err
+	}
+	if len(accountMapFile) != 0 {
+		azureData.EncodeAccountMappingFile(accountMapFile)
+	}
+
+	azure := api.NewCloudAccount(d.Get("name").(string),
+		api.AzureCfgCloudAccount,
+		azureData,
 	)
 
 	if !d.Get("enabled").(bool) {
@@ -151,11 +165,11 @@ func resourceLaceworkIntegrationAzureCfgCreate(d *schema.ResourceData, meta inte
 }
 
 func resourceLaceworkIntegrationAzureCfgRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	var response azureCfgIntegrationResponse
 
 	log.Printf("[INFO] Reading %s integration with guid: %v\n",
 		api.AzureCfgCloudAccount.String(), d.Id())
-	response, err := lacework.V2.CloudAccounts.GetAzureCfg(d.Id())
+	err := getCloudAccount(meta, d.Id(), &response)
 	if err != nil {
 		return resourceNotFound(d, err)
 	}
@@ -175,6 +189,14 @@ func resourceLaceworkIntegrationAzureCfgRead(d *schema.ResourceData, meta interf
 		d.Set("credentials", []map[string]string{creds})
 		d.Set("tenant_id", integration.Data.TenantID)
 
+		accountMapFile, err := integration.Data.DecodeAccountMappingFile()
+		if err != nil {
+			return err
+		}
+		if err := setResourceOrgAccountMappingsFile(d, azureOrgAccountMappings, accountMapFile); err != nil {
+			return err
+		}
+
 		log.Printf("[INFO] Read %s integration with guid: %v\n", api.AzureCfgCloudAccount.String(), integration.IntgGuid)
 		return nil
 	}
@@ -185,17 +207,30 @@ func resourceLaceworkIntegrationAzureCfgRead(d *schema.ResourceData, meta interf
 
 func resourceLaceworkIntegrationAzureCfgUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
-		azure    = api.NewCloudAccount(d.Get("name").(string),
-			api.AzureCfgCloudAccount,
-			api.AzureCfgData{
+		lacework  = meta.(*providerMeta).client
+		azureData = azureCfgData{
+			AzureCfgData: api.AzureCfgData{
 				TenantID: d.Get("tenant_id").(string),
 				Credentials: api.AzureCfgCredentials{
 					ClientID:     d.Get("credentials.0.client_id").(string),
 					ClientSecret: d.Get("credentials.0.client_secret").(string),
 				},
 			},
-		)
+		}
+	)
+
+	// verify if the user provided an account mapping
+	accountMapFile, err := getResourceOrgAccountMappingsFile(d, azureOrgAccountMappings)
+	if err != nil {
+		return err
+	}
+	if len(accountMapFile) != 0 {
+		azureData.EncodeAccountMappingFile(accountMapFile)
+	}
+
+	azure := api.NewCloudAccount(d.Get("name").(string),
+		api.AzureCfgCloudAccount,
+		azureData,
 	)
 
 	if !d.Get("enabled").(bool) {
@@ -226,7 +261,7 @@ func resourceLaceworkIntegrationAzureCfgUpdate(d *schema.ResourceData, meta inte
 }
 
 func resourceLaceworkIntegrationAzureCfgDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s integration with guid: %v\n",
 		api.AzureCfgCloudAccount.String(), d.Id())
@@ -239,3 +274,19 @@ func resourceLaceworkIntegrationAzureCfgDelete(d *schema.ResourceData, meta inte
 		api.AzureCfgCloudAccount.String(), d.Id())
 	return nil
 }
+
+// azureCfgData extends the data of Azure Config integrations from the Go SDK
+// with the account mapping file of tenant level integrations
+type azureCfgData struct {
+	api.AzureCfgData
+	orgAccountMappingFile
+}
+
+type azureCfgIntegration struct {
+	api.AzureCfg
+	Data azureCfgData `json:"data"`
+}
+
+type azureCfgIntegrationResponse struct {
+	Data azureCfgIntegration `json:"data"`
+}
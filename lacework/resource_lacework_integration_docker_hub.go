@@ -120,7 +120,7 @@ func resourceLaceworkIntegrationDockerHub() *schema.Resource {
 }
 
 func resourceLaceworkIntegrationDockerHubCreate(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 	data := api.NewContainerRegistry(d.Get("name").(string),
 		api.DockerhubContainerRegistry,
 		api.DockerhubData{
@@ -161,10 +161,10 @@ func resourceLaceworkIntegrationDockerHubCreate(d *schema.ResourceData, meta int
 }
 
 func resourceLaceworkIntegrationDockerHubRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	var response api.DockerhubIntegrationResponse
 
 	log.Printf("[INFO] Reading %s registry type with guid: %v\n", api.DockerhubContainerRegistry.String(), d.Id())
-	response, err := lacework.V2.ContainerRegistries.GetDockerhub(d.Id())
+	err := getContainerRegistry(meta, d.Id(), &response)
 
 	if err != nil {
 		return resourceNotFound(d, err)
@@ -198,7 +198,7 @@ func resourceLaceworkIntegrationDockerHubRead(d *schema.ResourceData, meta inter
 }
 
 func resourceLaceworkIntegrationDockerHubUpdate(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 	data := api.NewContainerRegistry(d.Get("name").(string),
 		api.DockerhubContainerRegistry,
 		api.DockerhubData{
@@ -244,7 +244,7 @@ func resourceLaceworkIntegrationDockerHubUpdate(d *schema.ResourceData, meta int
 }
 
 func resourceLaceworkIntegrationDockerHubDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s registry type with guid: %v\n", api.DockerhubContainerRegistry.String(), d.Id())
 
@@ -1,10 +1,12 @@
 package lacework
 
 import (
+	"context"
 	"log"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
 
 	"github.com/lacework/go-sdk/api"
 )
@@ -16,6 +18,8 @@ func resourceLaceworkIntegrationDockerV2() *schema.Resource {
 		Update: resourceLaceworkIntegrationDockerV2Update,
 		Delete: resourceLaceworkIntegrationDockerV2Delete,
 
+		CustomizeDiff: validateDockerV2RegistryCertificate,
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -48,6 +52,14 @@ func resourceLaceworkIntegrationDockerV2() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"ca_certificate": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The PEM encoded CA certificate used to verify registries with self-signed certificates",
+				StateFunc: func(val interface{}) string {
+					return strings.TrimSpace(val.(string))
+				},
+			},
 			"notifications": {
 				Type:        schema.TypeBool,
 				Description: "Subscribe to registry notifications",
@@ -65,6 +77,17 @@ func resourceLaceworkIntegrationDockerV2() *schema.Resource {
 				Optional:    true,
 				Description: "A list of image tags to limit the assessment of images with matching tags",
 			},
+			"limit_by_repositories": {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					StateFunc: func(val interface{}) string {
+						return strings.TrimSpace(val.(string))
+					},
+				},
+				Optional:    true,
+				Description: "A list of repositories to assess",
+			},
 			"limit_by_label": {
 				Type: schema.TypeSet,
 				Elem: &schema.Resource{
@@ -114,22 +137,10 @@ func resourceLaceworkIntegrationDockerV2() *schema.Resource {
 }
 
 func resourceLaceworkIntegrationDockerV2Create(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
-	notifications := d.Get("notifications").(bool)
+	lacework := meta.(*providerMeta).client
 	data := api.NewContainerRegistry(d.Get("name").(string),
 		api.DockerhubV2ContainerRegistry,
-		api.DockerhubV2Data{
-			LimitByTag:            castAttributeToStringSlice(d, "limit_by_tags"),
-			LimitByLabel:          castAttributeToArrayOfKeyValueMap(d, "limit_by_label"),
-			RegistryDomain:        d.Get("registry_domain").(string),
-			NonOSPackageEval:      d.Get("non_os_package_support").(bool),
-			RegistryNotifications: &notifications,
-			Credentials: api.DockerhubV2Credentials{
-				Username: d.Get("username").(string),
-				Password: d.Get("password").(string),
-				SSL:      d.Get("ssl").(bool),
-			},
-		},
+		expandDockerV2RegistryData(d),
 	)
 
 	if !d.Get("enabled").(bool) {
@@ -157,10 +168,9 @@ func resourceLaceworkIntegrationDockerV2Create(d *schema.ResourceData, meta inte
 }
 
 func resourceLaceworkIntegrationDockerV2Read(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
-
+	var response dockerV2RegistryResponse
 	log.Printf("[INFO] Reading %s registry type with guid: %v\n", api.DockerhubV2ContainerRegistry.String(), d.Id())
-	response, err := lacework.V2.ContainerRegistries.GetDockerhubV2(d.Id())
+	err := getContainerRegistry(meta, d.Id(), &response)
 
 	if err != nil {
 		return resourceNotFound(d, err)
@@ -180,9 +190,11 @@ func resourceLaceworkIntegrationDockerV2Read(d *schema.ResourceData, meta interf
 		d.Set("username", integration.Data.Credentials.Username)
 		d.Set("password", integration.Data.Credentials.Password)
 		d.Set("ssl", integration.Data.Credentials.SSL)
+		d.Set("ca_certificate", integration.Data.Credentials.CaCert)
 		d.Set("non_os_package_support", integration.Data.NonOSPackageEval)
 		d.Set("notifications", integration.Data.RegistryNotifications)
 		d.Set("limit_by_tags", response.Data.Data.LimitByTag)
+		d.Set("limit_by_repositories", response.Data.Data.LimitByRep)
 		if limitByLabelsLength(response.Data.Data.LimitByLabel) != 0 {
 			d.Set("limit_by_label", castArrayOfStringKeyMapOfStringsToLimitByLabelSet(response.Data.Data.LimitByLabel))
 		}
@@ -196,22 +208,10 @@ func resourceLaceworkIntegrationDockerV2Read(d *schema.ResourceData, meta interf
 }
 
 func resourceLaceworkIntegrationDockerV2Update(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
-	notifications := d.Get("notifications").(bool)
+	lacework := meta.(*providerMeta).client
 	data := api.NewContainerRegistry(d.Get("name").(string),
 		api.DockerhubV2ContainerRegistry,
-		api.DockerhubV2Data{
-			LimitByTag:            castAttributeToStringSlice(d, "limit_by_tags"),
-			LimitByLabel:          castAttributeToArrayOfKeyValueMap(d, "limit_by_label"),
-			RegistryDomain:        d.Get("registry_domain").(string),
-			NonOSPackageEval:      d.Get("non_os_package_support").(bool),
-			RegistryNotifications: &notifications,
-			Credentials: api.DockerhubV2Credentials{
-				Username: d.Get("username").(string),
-				Password: d.Get("password").(string),
-				SSL:      d.Get("ssl").(bool),
-			},
-		},
+		expandDockerV2RegistryData(d),
 	)
 
 	if !d.Get("enabled").(bool) {
@@ -245,7 +245,7 @@ func resourceLaceworkIntegrationDockerV2Update(d *schema.ResourceData, meta inte
 }
 
 func resourceLaceworkIntegrationDockerV2Delete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s registry type with guid: %v\n", api.DockerhubV2ContainerRegistry.String(), d.Id())
 
@@ -258,3 +258,60 @@ func resourceLaceworkIntegrationDockerV2Delete(d *schema.ResourceData, meta inte
 
 	return nil
 }
+
+// validateDockerV2RegistryCertificate is a CustomizeDiff function that verifies,
+// at plan time, that SSL is enabled when a CA certificate is provided
+func validateDockerV2RegistryCertificate(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if !diff.NewValueKnown("ca_certificate") || !diff.NewValueKnown("ssl") {
+		return nil
+	}
+	if diff.Get("ca_certificate").(string) != "" && !diff.Get("ssl").(bool) {
+		return errors.New("ca_certificate requires ssl to be enabled")
+	}
+	return nil
+}
+
+// dockerV2RegistryData extends the data of Docker V2 registries from the Go SDK
+// with the settings to limit by repository and to use self-signed certificates
+type dockerV2RegistryData struct {
+	api.DockerhubV2Data
+	Credentials dockerV2RegistryCredentials `json:"credentials"`
+	LimitByRep  []string                    `json:"limitByRep,omitempty"`
+}
+
+type dockerV2RegistryCredentials struct {
+	api.DockerhubV2Credentials
+	CaCert string `json:"caCert,omitempty"`
+}
+
+type dockerV2RegistryIntegration struct {
+	api.DockerhubV2Integration
+	Data dockerV2RegistryData `json:"data"`
+}
+
+type dockerV2RegistryResponse struct {
+	Data dockerV2RegistryIntegration `json:"data"`
+}
+
+func expandDockerV2RegistryData(d *schema.ResourceData) dockerV2RegistryData {
+	notifications := d.Get("notifications").(bool)
+	return dockerV2RegistryData{
+		DockerhubV2Data: api.DockerhubV2Data{
+			LimitByTag:            castAttributeToStringSlice(d, "limit_by_tags"),
+			LimitByLabel:          castAttributeToArrayOfKeyValueMap(d, "limit_by_label"),
+			RegistryDomain:        d.Get("registry_domain").(string),
+			RegistryType:          api.DockerhubV2ContainerRegistry.String(),
+			NonOSPackageEval:      d.Get("non_os_package_support").(bool),
+			RegistryNotifications: &notifications,
+		},
+		Credentials: dockerV2RegistryCredentials{
+			DockerhubV2Credentials: api.DockerhubV2Credentials{
+				Username: d.Get("username").(string),
+				Password: d.Get("password").(string),
+				SSL:      d.Get("ssl").(bool),
+			},
+			CaCert: strings.TrimSpace(d.Get("ca_certificate").(string)),
+		},
+		LimitByRep: castAttributeToStringSlice(d, "limit_by_repositories"),
+	}
+}
@@ -0,0 +1,52 @@
+package lacework
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandDockerV2RegistryData(t *testing.T) {
+	d := resourceLaceworkIntegrationDockerV2().TestResourceData()
+	d.Set("name", "harbor")
+	d.Set("registry_domain", "harbor.example.com:443")
+	d.Set("username", "robot")
+	d.Set("password", "secret")
+	d.Set("ssl", true)
+	d.Set("non_os_package_support", true)
+	d.Set("ca_certificate", "-----BEGIN CERTIFICATE-----\nabc\n-----END CERTIFICATE-----\n")
+	d.Set("limit_by_repositories", []string{"team/app"})
+	d.Set("limit_by_tags", []string{"prod-*"})
+
+	raw, err := json.Marshal(expandDockerV2RegistryData(d))
+	if assert.NoError(t, err) {
+		assert.JSONEq(t, `{
+			"credentials": {
+				"username": "robot",
+				"password": "secret",
+				"ssl": true,
+				"caCert": "-----BEGIN CERTIFICATE-----\nabc\n-----END CERTIFICATE-----"
+			},
+			"registryDomain": "harbor.example.com:443",
+			"registryType": "V2_REGISTRY",
+			"registryNotifications": false,
+			"limitByTag": ["prod-*"],
+			"limitByRep": ["team/app"],
+			"nonOsPackageEval": true
+		}`, string(raw))
+	}
+
+	var response dockerV2RegistryResponse
+	err = json.Unmarshal([]byte(`{"data": {
+		"intgGuid": "GUID",
+		"name": "harbor",
+		"data": {"credentials": {"username": "robot", "ssl": true, "caCert": "CA"}, "limitByRep": ["team/app"]}
+	}}`), &response)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "GUID", response.Data.IntgGuid)
+		assert.Equal(t, "robot", response.Data.Data.Credentials.Username)
+		assert.Equal(t, "CA", response.Data.Data.Credentials.CaCert)
+		assert.Equal(t, []string{"team/app"}, response.Data.Data.LimitByRep)
+	}
+}
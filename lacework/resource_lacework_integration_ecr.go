@@ -14,14 +14,13 @@ import (
 )
 
 func importLaceworkECRIntegration(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	lacework := meta.(*api.Client)
 	var awsAuthType string
 
 	log.Printf("[INFO] Importing Lacework integration with guid: %s\n", d.Id())
 
 	var response api.ContainerRegistryRaw
 
-	err := lacework.V2.ContainerRegistries.Get(d.Id(), &response)
+	err := getContainerRegistry(meta, d.Id(), &response)
 	if err != nil {
 		return nil, err
 	}
@@ -207,7 +206,7 @@ func resourceLaceworkIntegrationEcr() *schema.Resource {
 }
 
 func resourceLaceworkIntegrationEcrCreate(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	switch detectAuthenticationMethod(d) {
 	case api.AwsEcrAccessKey.String():
@@ -247,7 +246,7 @@ func resourceLaceworkIntegrationEcrRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceLaceworkIntegrationEcrUpdate(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	switch d.Get("aws_auth_type").(string) {
 	case api.AwsEcrAccessKey.String():
@@ -396,7 +395,7 @@ func resourceLaceworkIntegrationEcrUpdateWithAccessKey(d *schema.ResourceData, l
 }
 
 func resourceLaceworkIntegrationEcrDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s registry type with guid: %v\n", api.AwsEcrContainerRegistry.String(), d.Id())
 
@@ -563,8 +562,9 @@ func resourceLaceworkIntegrationEcrCreateWithIAMRole(d *schema.ResourceData, lac
 }
 
 func readEcrIam(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
-	response, err := lacework.V2.ContainerRegistries.GetAwsEcrIamRole(d.Id())
+	var response api.AwsEcrIamRoleIntegrationResponse
+
+	err := getContainerRegistry(meta, d.Id(), &response)
 	if err != nil {
 		return resourceNotFound(d, err)
 	}
@@ -614,8 +614,9 @@ func limitByLabelsLength(labels []map[string]string) int {
 }
 
 func readEcrAccessKey(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
-	response, err := lacework.V2.ContainerRegistries.GetAwsEcrAccessKey(d.Id())
+	var response api.AwsEcrAccessKeyIntegrationResponse
+
+	err := getContainerRegistry(meta, d.Id(), &response)
 	if err != nil {
 		return resourceNotFound(d, err)
 	}
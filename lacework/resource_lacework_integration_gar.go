@@ -201,7 +201,7 @@ func resourceLaceworkIntegrationGar() *schema.Resource {
 }
 
 func resourceLaceworkIntegrationGarCreate(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	data := api.NewContainerRegistry(d.Get("name").(string),
 		api.GcpGarContainerRegistry,
@@ -247,11 +247,11 @@ func resourceLaceworkIntegrationGarCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceLaceworkIntegrationGarRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	var response api.GcpGarIntegrationResponse
 
 	log.Printf("[INFO] Reading ContVulnCfg integration for %s registry type with guid %s\n",
 		api.GcpGarContainerRegistry.String(), d.Id())
-	response, err := lacework.V2.ContainerRegistries.GetGcpGar(d.Id())
+	err := getContainerRegistry(meta, d.Id(), &response)
 	if err != nil {
 		return resourceNotFound(d, err)
 	}
@@ -283,7 +283,7 @@ func resourceLaceworkIntegrationGarRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceLaceworkIntegrationGarUpdate(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	data := api.NewContainerRegistry(d.Get("name").(string),
 		api.GcpGarContainerRegistry,
@@ -330,7 +330,7 @@ func resourceLaceworkIntegrationGarUpdate(d *schema.ResourceData, meta interface
 }
 
 func resourceLaceworkIntegrationGarDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting ContVulnCfg integration for %s registry type with guid %s\n",
 		api.GcpGarContainerRegistry.String(), d.Id())
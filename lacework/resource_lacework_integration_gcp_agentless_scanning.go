@@ -214,7 +214,7 @@ func resourceLaceworkIntegrationGcpAgentlessScanning() *schema.Resource {
 
 func resourceLaceworkIntegrationGcpAgentlessScanningCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework      = meta.(*api.Client)
+		lacework      = meta.(*providerMeta).client
 		retries       = d.Get("retries").(int)
 		resourceLevel = api.GcpProjectIntegration
 	)
@@ -297,11 +297,11 @@ func resourceLaceworkIntegrationGcpAgentlessScanningCreate(d *schema.ResourceDat
 }
 
 func resourceLaceworkIntegrationGcpAgentlessScanningRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	var response api.GcpSidekickIntegrationResponse
 
 	log.Printf("[INFO] Reading %s integration with guid: %v\n",
 		api.GcpSidekickCloudAccount.String(), d.Id())
-	response, err := lacework.V2.CloudAccounts.GetGcpSidekick(d.Id())
+	err := getCloudAccount(meta, d.Id(), &response)
 	if err != nil {
 		return resourceNotFound(d, err)
 	}
@@ -351,7 +351,7 @@ func resourceLaceworkIntegrationGcpAgentlessScanningRead(d *schema.ResourceData,
 
 func resourceLaceworkIntegrationGcpAgentlessScanningUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework      = meta.(*api.Client)
+		lacework      = meta.(*providerMeta).client
 		resourceLevel = api.GcpProjectIntegration
 	)
 
@@ -416,7 +416,7 @@ func resourceLaceworkIntegrationGcpAgentlessScanningUpdate(d *schema.ResourceDat
 }
 
 func resourceLaceworkIntegrationGcpAgentlessScanningDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s integration with guid: %v\n",
 		api.GcpSidekickCloudAccount.String(), d.Id())
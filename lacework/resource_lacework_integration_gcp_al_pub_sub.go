@@ -20,6 +20,8 @@ func resourceLaceworkIntegrationGcpPubSubAuditLog() *schema.Resource {
 		Delete:   resourceLaceworkIntegrationGcpPubSubAuditLogDelete,
 		Schema:   gcpPubSubAuditLogIntegrationSchema,
 		Importer: &schema.ResourceImporter{StateContext: schema.ImportStatePassthroughContext},
+
+		CustomizeDiff: validateGcpOrgAccountMappings("integration_type"),
 	}
 }
 
@@ -131,6 +133,7 @@ var gcpPubSubAuditLogIntegrationSchema = map[string]*schema.Schema{
 		Required:    true,
 		Description: "The PubSub topic id.",
 	},
+	"org_account_mappings": orgAccountMappingsSchema(gcpOrgAccountMappings),
 	"is_org": {
 		Type:     schema.TypeBool,
 		Computed: true,
@@ -147,23 +150,34 @@ var gcpPubSubAuditLogIntegrationSchema = map[string]*schema.Schema{
 
 func resourceLaceworkIntegrationGcpPubSubAuditLogCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework              = meta.(*api.Client)
+		lacework              = meta.(*providerMeta).client
 		retries               = d.Get("retries").(int)
-		gcpPubSubAuditLogData = api.GcpAlPubSubSesData{
-			Credentials: api.GcpAlPubSubCredentials{
-				ClientID:     d.Get("credentials.0.client_id").(string),
-				ClientEmail:  d.Get("credentials.0.client_email").(string),
-				PrivateKeyID: d.Get("credentials.0.private_key_id").(string),
-				PrivateKey:   d.Get("credentials.0.private_key").(string),
+		gcpPubSubAuditLogData = gcpAlPubSubData{
+			GcpAlPubSubSesData: api.GcpAlPubSubSesData{
+				Credentials: api.GcpAlPubSubCredentials{
+					ClientID:     d.Get("credentials.0.client_id").(string),
+					ClientEmail:  d.Get("credentials.0.client_email").(string),
+					PrivateKeyID: d.Get("credentials.0.private_key_id").(string),
+					PrivateKey:   d.Get("credentials.0.private_key").(string),
+				},
+				IntegrationType:  strings.ToUpper(d.Get("integration_type").(string)),
+				OrganizationID:   d.Get("organization_id").(string),
+				ProjectID:        d.Get("project_id").(string),
+				SubscriptionName: d.Get("subscription_id").(string),
+				TopicID:          d.Get("topic_id").(string),
 			},
-			IntegrationType:  strings.ToUpper(d.Get("integration_type").(string)),
-			OrganizationID:   d.Get("organization_id").(string),
-			ProjectID:        d.Get("project_id").(string),
-			SubscriptionName: d.Get("subscription_id").(string),
-			TopicID:          d.Get("topic_id").(string),
 		}
 	)
 
+	// verify if the user provided an account mapping
+	accountMapFile, err := getResourceOrgAccountMappingsFile(d, gcpOrgAccountMappings)
+	if err != nil {
+		return err
+	}
+	if len(accountMapFile) != 0 {
+		gcpPubSubAuditLogData.EncodeAccountMappingFile(accountMapFile)
+	}
+
 	if d.Get("integration_type").(string) == "ORGANIZATION" &&
 		(d.Get("organization_id") == nil || d.Get("organization_id") == "") {
 		return fmt.Errorf("error creating cloud account integration: " +
@@ -218,10 +232,10 @@ func resourceLaceworkIntegrationGcpPubSubAuditLogCreate(d *schema.ResourceData,
 }
 
 func resourceLaceworkIntegrationGcpPubSubAuditLogRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	var response gcpAlPubSubIntegrationResponse
 
 	log.Printf("[INFO] Reading %s cloud account integration with guid: %v\n", api.GcpAlPubSubCloudAccount.String(), d.Id())
-	response, err := lacework.V2.CloudAccounts.GetGcpAlPubSub(d.Id())
+	err := getCloudAccount(meta, d.Id(), &response)
 	if err != nil {
 		return resourceNotFound(d, err)
 	}
@@ -247,6 +261,14 @@ func resourceLaceworkIntegrationGcpPubSubAuditLogRead(d *schema.ResourceData, me
 		d.Set("subscription_id", cloudAccount.Data.SubscriptionName)
 		d.Set("topic_id", cloudAccount.Data.TopicID)
 
+		accountMapFile, err := cloudAccount.Data.DecodeAccountMappingFile()
+		if err != nil {
+			return err
+		}
+		if err := setResourceOrgAccountMappingsFile(d, gcpOrgAccountMappings, accountMapFile); err != nil {
+			return err
+		}
+
 		log.Printf("[INFO] Read %s cloud account integration with guid: %v\n",
 			api.GcpAlPubSubCloudAccount.String(), cloudAccount.IntgGuid,
 		)
@@ -259,22 +281,33 @@ func resourceLaceworkIntegrationGcpPubSubAuditLogRead(d *schema.ResourceData, me
 
 func resourceLaceworkIntegrationGcpPubSubAuditLogUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework              = meta.(*api.Client)
-		gcpPubSubAuditLogData = api.GcpAlPubSubSesData{
-			Credentials: api.GcpAlPubSubCredentials{
-				ClientID:     d.Get("credentials.0.client_id").(string),
-				ClientEmail:  d.Get("credentials.0.client_email").(string),
-				PrivateKeyID: d.Get("credentials.0.private_key_id").(string),
-				PrivateKey:   d.Get("credentials.0.private_key").(string),
+		lacework              = meta.(*providerMeta).client
+		gcpPubSubAuditLogData = gcpAlPubSubData{
+			GcpAlPubSubSesData: api.GcpAlPubSubSesData{
+				Credentials: api.GcpAlPubSubCredentials{
+					ClientID:     d.Get("credentials.0.client_id").(string),
+					ClientEmail:  d.Get("credentials.0.client_email").(string),
+					PrivateKeyID: d.Get("credentials.0.private_key_id").(string),
+					PrivateKey:   d.Get("credentials.0.private_key").(string),
+				},
+				IntegrationType:  strings.ToUpper(d.Get("integration_type").(string)),
+				OrganizationID:   d.Get("organization_id").(string),
+				ProjectID:        d.Get("project_id").(string),
+				SubscriptionName: d.Get("subscription_id").(string),
+				TopicID:          d.Get("topic_id").(string),
 			},
-			IntegrationType:  strings.ToUpper(d.Get("integration_type").(string)),
-			OrganizationID:   d.Get("organization_id").(string),
-			ProjectID:        d.Get("project_id").(string),
-			SubscriptionName: d.Get("subscription_id").(string),
-			TopicID:          d.Get("topic_id").(string),
 		}
 	)
 
+	// verify if the user provided an account mapping
+	accountMapFile, err := getResourceOrgAccountMappingsFile(d, gcpOrgAccountMappings)
+	if err != nil {
+		return err
+	}
+	if len(accountMapFile) != 0 {
+		gcpPubSubAuditLogData.EncodeAccountMappingFile(accountMapFile)
+	}
+
 	gcpPubSubAuditLog := api.NewCloudAccount(d.Get("name").(string),
 		api.GcpAlPubSubCloudAccount,
 		gcpPubSubAuditLogData,
@@ -312,7 +345,7 @@ func resourceLaceworkIntegrationGcpPubSubAuditLogUpdate(d *schema.ResourceData,
 }
 
 func resourceLaceworkIntegrationGcpPubSubAuditLogDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s cloud account integration with guid: %v\n", api.GcpAlPubSubCloudAccount.String(), d.Id())
 	err := lacework.V2.CloudAccounts.Delete(d.Id())
@@ -323,3 +356,19 @@ func resourceLaceworkIntegrationGcpPubSubAuditLogDelete(d *schema.ResourceData,
 	log.Printf("[INFO] Deleted %s cloud account integration with guid: %v\n", api.GcpAlPubSubCloudAccount.String(), d.Id())
 	return nil
 }
+
+// gcpAlPubSubData extends the data of GCP Pub Sub Audit Log integrations from the Go SDK
+// with the account mapping file of organization level integrations
+type gcpAlPubSubData struct {
+	api.GcpAlPubSubSesData
+	orgAccountMappingFile
+}
+
+type gcpAlPubSubIntegration struct {
+	api.V2GcpAlPubSubIntegration
+	Data gcpAlPubSubData `json:"data"`
+}
+
+type gcpAlPubSubIntegrationResponse struct {
+	Data gcpAlPubSubIntegration `json:"data"`
+}
@@ -19,6 +19,8 @@ func resourceLaceworkIntegrationGcpAt() *schema.Resource {
 		Update: resourceLaceworkIntegrationGcpAtUpdate,
 		Delete: resourceLaceworkIntegrationGcpAtDelete,
 
+		CustomizeDiff: validateGcpOrgAccountMappings("resource_level"),
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -115,6 +117,7 @@ func resourceLaceworkIntegrationGcpAt() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"org_account_mappings": orgAccountMappingsSchema(gcpOrgAccountMappings),
 			"created_or_updated_time": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -137,7 +140,7 @@ func resourceLaceworkIntegrationGcpAt() *schema.Resource {
 
 func resourceLaceworkIntegrationGcpAtCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework      = meta.(*api.Client)
+		lacework      = meta.(*providerMeta).client
 		retries       = d.Get("retries").(int)
 		resourceLevel = api.GcpProjectIntegration
 	)
@@ -149,9 +152,8 @@ func resourceLaceworkIntegrationGcpAtCreate(d *schema.ResourceData, meta interfa
 		resourceLevel = api.GcpOrganizationIntegration
 	}
 
-	data := api.NewCloudAccount(d.Get("name").(string),
-		api.GcpAtSesCloudAccount,
-		api.GcpAtSesData{
+	integrationData := gcpAtSesData{
+		GcpAtSesData: api.GcpAtSesData{
 			ID:     d.Get("resource_id").(string),
 			IDType: resourceLevel.String(),
 			Credentials: api.GcpAtSesCredentials{
@@ -162,6 +164,20 @@ func resourceLaceworkIntegrationGcpAtCreate(d *schema.ResourceData, meta interfa
 			},
 			SubscriptionName: d.Get("subscription").(string),
 		},
+	}
+
+	// verify if the user provided an account mapping
+	accountMapFile, err := getResourceOrgAccountMappingsFile(d, gcpOrgAccountMappings)
+	if err != nil {
+		return err
+	}
+	if len(accountMapFile) != 0 {
+		integrationData.EncodeAccountMappingFile(accountMapFile)
+	}
+
+	data := api.NewCloudAccount(d.Get("name").(string),
+		api.GcpAtSesCloudAccount,
+		integrationData,
 	)
 
 	if !d.Get("enabled").(bool) {
@@ -206,11 +222,11 @@ func resourceLaceworkIntegrationGcpAtCreate(d *schema.ResourceData, meta interfa
 }
 
 func resourceLaceworkIntegrationGcpAtRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	var response gcpAtSesIntegrationResponse
 
 	log.Printf("[INFO] Reading %s integration with guid: %v\n",
 		api.GcpAtSesCloudAccount.String(), d.Id())
-	response, err := lacework.V2.CloudAccounts.GetGcpAtSes(d.Id())
+	err := getCloudAccount(meta, d.Id(), &response)
 
 	if err != nil {
 		return resourceNotFound(d, err)
@@ -234,6 +250,14 @@ func resourceLaceworkIntegrationGcpAtRead(d *schema.ResourceData, meta interface
 		d.Set("resource_id", integration.Data.ID)
 		d.Set("subscription", integration.Data.SubscriptionName)
 
+		accountMapFile, err := integration.Data.DecodeAccountMappingFile()
+		if err != nil {
+			return err
+		}
+		if err := setResourceOrgAccountMappingsFile(d, gcpOrgAccountMappings, accountMapFile); err != nil {
+			return err
+		}
+
 		log.Printf("[INFO] Read %s integration with guid: %v\n",
 			api.GcpAtSesCloudAccount.String(), integration.IntgGuid)
 		return nil
@@ -245,7 +269,7 @@ func resourceLaceworkIntegrationGcpAtRead(d *schema.ResourceData, meta interface
 
 func resourceLaceworkIntegrationGcpAtUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework      = meta.(*api.Client)
+		lacework      = meta.(*providerMeta).client
 		resourceLevel = api.GcpProjectIntegration
 	)
 
@@ -253,9 +277,8 @@ func resourceLaceworkIntegrationGcpAtUpdate(d *schema.ResourceData, meta interfa
 		resourceLevel = api.GcpOrganizationIntegration
 	}
 
-	data := api.NewCloudAccount(d.Get("name").(string),
-		api.GcpAtSesCloudAccount,
-		api.GcpAtSesData{
+	integrationData := gcpAtSesData{
+		GcpAtSesData: api.GcpAtSesData{
 			ID:     d.Get("resource_id").(string),
 			IDType: resourceLevel.String(),
 			Credentials: api.GcpAtSesCredentials{
@@ -266,6 +289,20 @@ func resourceLaceworkIntegrationGcpAtUpdate(d *schema.ResourceData, meta interfa
 			},
 			SubscriptionName: d.Get("subscription").(string),
 		},
+	}
+
+	// verify if the user provided an account mapping
+	accountMapFile, err := getResourceOrgAccountMappingsFile(d, gcpOrgAccountMappings)
+	if err != nil {
+		return err
+	}
+	if len(accountMapFile) != 0 {
+		integrationData.EncodeAccountMappingFile(accountMapFile)
+	}
+
+	data := api.NewCloudAccount(d.Get("name").(string),
+		api.GcpAtSesCloudAccount,
+		integrationData,
 	)
 
 	if !d.Get("enabled").(bool) {
@@ -300,7 +337,7 @@ func resourceLaceworkIntegrationGcpAtUpdate(d *schema.ResourceData, meta interfa
 }
 
 func resourceLaceworkIntegrationGcpAtDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s integration with guid: %v\n",
 		api.GcpAtSesCloudAccount.String(), d.Id())
@@ -315,3 +352,19 @@ func resourceLaceworkIntegrationGcpAtDelete(d *schema.ResourceData, meta interfa
 
 	return nil
 }
+
+// gcpAtSesData extends the data of GCP Audit Log integrations from the Go SDK
+// with the account mapping file of organization level integrations
+type gcpAtSesData struct {
+	api.GcpAtSesData
+	orgAccountMappingFile
+}
+
+type gcpAtSesIntegration struct {
+	api.V2GcpAtSesIntegration
+	Data gcpAtSesData `json:"data"`
+}
+
+type gcpAtSesIntegrationResponse struct {
+	Data gcpAtSesIntegration `json:"data"`
+}
@@ -19,6 +19,8 @@ func resourceLaceworkIntegrationGcpCfg() *schema.Resource {
 		Update: resourceLaceworkIntegrationGcpCfgUpdate,
 		Delete: resourceLaceworkIntegrationGcpCfgDelete,
 
+		CustomizeDiff: validateGcpOrgAccountMappings("resource_level"),
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -112,6 +114,7 @@ func resourceLaceworkIntegrationGcpCfg() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"org_account_mappings": orgAccountMappingsSchema(gcpOrgAccountMappings),
 			"created_or_updated_time": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -134,7 +137,7 @@ func resourceLaceworkIntegrationGcpCfg() *schema.Resource {
 
 func resourceLaceworkIntegrationGcpCfgCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework      = meta.(*api.Client)
+		lacework      = meta.(*providerMeta).client
 		retries       = d.Get("retries").(int)
 		resourceLevel = api.GcpProjectIntegration
 	)
@@ -145,9 +148,8 @@ func resourceLaceworkIntegrationGcpCfgCreate(d *schema.ResourceData, meta interf
 		resourceLevel = api.GcpOrganizationIntegration
 	}
 
-	data := api.NewCloudAccount(d.Get("name").(string),
-		api.GcpCfgCloudAccount,
-		api.GcpCfgData{
+	integrationData := gcpCfgData{
+		GcpCfgData: api.GcpCfgData{
 			ID:     d.Get("resource_id").(string),
 			IDType: resourceLevel.String(),
 			Credentials: api.GcpCfgCredentials{
@@ -157,6 +159,20 @@ func resourceLaceworkIntegrationGcpCfgCreate(d *schema.ResourceData, meta interf
 				PrivateKey:   d.Get("credentials.0.private_key").(string),
 			},
 		},
+	}
+
+	// verify if the user provided an account mapping
+	accountMapFile, err := getResourceOrgAccountMappingsFile(d, gcpOrgAccountMappings)
+	if err != nil {
+		return err
+	}
+	if len(accountMapFile) != 0 {
+		integrationData.EncodeAccountMappingFile(accountMapFile)
+	}
+
+	data := api.NewCloudAccount(d.Get("name").(string),
+		api.GcpCfgCloudAccount,
+		integrationData,
 	)
 
 	if !d.Get("enabled").(bool) {
@@ -203,11 +219,11 @@ func resourceLaceworkIntegrationGcpCfgCreate(d *schema.ResourceData, meta interf
 }
 
 func resourceLaceworkIntegrationGcpCfgRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	var response gcpCfgIntegrationResponse
 
 	log.Printf("[INFO] Reading %s integration with guid: %v\n",
 		api.GcpCfgCloudAccount.String(), d.Id())
-	response, err := lacework.V2.CloudAccounts.GetGcpCfg(d.Id())
+	err := getCloudAccount(meta, d.Id(), &response)
 	if err != nil {
 		return resourceNotFound(d, err)
 	}
@@ -229,6 +245,14 @@ func resourceLaceworkIntegrationGcpCfgRead(d *schema.ResourceData, meta interfac
 		d.Set("resource_level", integration.Data.IDType)
 		d.Set("resource_id", integration.Data.ID)
 
+		accountMapFile, err := integration.Data.DecodeAccountMappingFile()
+		if err != nil {
+			return err
+		}
+		if err := setResourceOrgAccountMappingsFile(d, gcpOrgAccountMappings, accountMapFile); err != nil {
+			return err
+		}
+
 		log.Printf("[INFO] Read %s integration with guid: %v\n",
 			api.GcpCfgCloudAccount.String(), integration.IntgGuid)
 		return nil
@@ -240,7 +264,7 @@ func resourceLaceworkIntegrationGcpCfgRead(d *schema.ResourceData, meta interfac
 
 func resourceLaceworkIntegrationGcpCfgUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework      = meta.(*api.Client)
+		lacework      = meta.(*providerMeta).client
 		resourceLevel = api.GcpProjectIntegration
 	)
 
@@ -248,9 +272,8 @@ func resourceLaceworkIntegrationGcpCfgUpdate(d *schema.ResourceData, meta interf
 		resourceLevel = api.GcpOrganizationIntegration
 	}
 
-	data := api.NewCloudAccount(d.Get("name").(string),
-		api.GcpCfgCloudAccount,
-		api.GcpCfgData{
+	integrationData := gcpCfgData{
+		GcpCfgData: api.GcpCfgData{
 			ID:     d.Get("resource_id").(string),
 			IDType: resourceLevel.String(),
 			Credentials: api.GcpCfgCredentials{
@@ -260,6 +283,20 @@ func resourceLaceworkIntegrationGcpCfgUpdate(d *schema.ResourceData, meta interf
 				PrivateKey:   d.Get("credentials.0.private_key").(string),
 			},
 		},
+	}
+
+	// verify if the user provided an account mapping
+	accountMapFile, err := getResourceOrgAccountMappingsFile(d, gcpOrgAccountMappings)
+	if err != nil {
+		return err
+	}
+	if len(accountMapFile) != 0 {
+		integrationData.EncodeAccountMappingFile(accountMapFile)
+	}
+
+	data := api.NewCloudAccount(d.Get("name").(string),
+		api.GcpCfgCloudAccount,
+		integrationData,
 	)
 
 	if !d.Get("enabled").(bool) {
@@ -293,7 +330,7 @@ func resourceLaceworkIntegrationGcpCfgUpdate(d *schema.ResourceData, meta interf
 }
 
 func resourceLaceworkIntegrationGcpCfgDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s integration with guid: %v\n",
 		api.GcpCfgCloudAccount.String(), d.Id())
@@ -306,3 +343,19 @@ func resourceLaceworkIntegrationGcpCfgDelete(d *schema.ResourceData, meta interf
 		api.GcpCfgCloudAccount.String(), d.Id())
 	return nil
 }
+
+// gcpCfgData extends the data of GCP Config integrations from the Go SDK
+// with the account mapping file of organization level integrations
+type gcpCfgData struct {
+	api.GcpCfgData
+	orgAccountMappingFile
+}
+
+type gcpCfgIntegration struct {
+	api.V2GcpCfgIntegration
+	Data gcpCfgData `json:"data"`
+}
+
+type gcpCfgIntegrationResponse struct {
+	Data gcpCfgIntegration `json:"data"`
+}
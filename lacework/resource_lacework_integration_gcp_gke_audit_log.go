@@ -142,7 +142,7 @@ var gcpGkeAuditLogIntegrationSchema = map[string]*schema.Schema{
 
 func resourceLaceworkIntegrationGcpGkeAuditLogCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework           = meta.(*api.Client)
+		lacework           = meta.(*providerMeta).client
 		retries            = d.Get("retries").(int)
 		gcpGkeAuditLogData = api.GcpGkeAuditData{
 			Credentials: api.GcpGkeAuditCredentials{
@@ -212,10 +212,10 @@ func resourceLaceworkIntegrationGcpGkeAuditLogCreate(d *schema.ResourceData, met
 }
 
 func resourceLaceworkIntegrationGcpGkeAuditLogRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	var response api.GcpGkeAuditIntegrationResponse
 
 	log.Printf("[INFO] Reading %s cloud account integration with guid: %v\n", api.GcpGkeAuditCloudAccount.String(), d.Id())
-	response, err := lacework.V2.CloudAccounts.GetGcpGkeAudit(d.Id())
+	err := getCloudAccount(meta, d.Id(), &response)
 	if err != nil {
 		return resourceNotFound(d, err)
 	}
@@ -252,7 +252,7 @@ func resourceLaceworkIntegrationGcpGkeAuditLogRead(d *schema.ResourceData, meta
 
 func resourceLaceworkIntegrationGcpGkeAuditLogUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework           = meta.(*api.Client)
+		lacework           = meta.(*providerMeta).client
 		gcpGkeAuditLogData = api.GcpGkeAuditData{
 			Credentials: api.GcpGkeAuditCredentials{
 				ClientId:     d.Get("credentials.0.client_id").(string),
@@ -304,7 +304,7 @@ func resourceLaceworkIntegrationGcpGkeAuditLogUpdate(d *schema.ResourceData, met
 }
 
 func resourceLaceworkIntegrationGcpGkeAuditLogDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s cloud account integration with guid: %v\n", api.GcpGkeAuditCloudAccount.String(), d.Id())
 	err := lacework.V2.CloudAccounts.Delete(d.Id())
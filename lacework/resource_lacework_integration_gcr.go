@@ -165,7 +165,7 @@ func resourceLaceworkIntegrationGcr() *schema.Resource {
 }
 
 func resourceLaceworkIntegrationGcrCreate(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 	gcrData := api.GcpGcrData{
 		LimitByTag:       castAttributeToStringSlice(d, "limit_by_tags"),
 		LimitByRep:       castAttributeToStringSlice(d, "limit_by_repositories"),
@@ -210,10 +210,10 @@ func resourceLaceworkIntegrationGcrCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceLaceworkIntegrationGcrRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	var response api.GcpGcrIntegrationResponse
 
 	log.Printf("[INFO] Reading %s registry type with guid: %v\n", api.GcpGcrContainerRegistry.String(), d.Id())
-	response, err := lacework.V2.ContainerRegistries.GetGcpGcr(d.Id())
+	err := getContainerRegistry(meta, d.Id(), &response)
 
 	if err != nil {
 		return resourceNotFound(d, err)
@@ -251,7 +251,7 @@ func resourceLaceworkIntegrationGcrRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceLaceworkIntegrationGcrUpdate(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	gcrData := api.GcpGcrData{
 		LimitByTag:       castAttributeToStringSlice(d, "limit_by_tags"),
@@ -303,7 +303,7 @@ func resourceLaceworkIntegrationGcrUpdate(d *schema.ResourceData, meta interface
 }
 
 func resourceLaceworkIntegrationGcrDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s registry type with guid: %v\n", api.GcpGcrContainerRegistry.String(), d.Id())
 
@@ -136,7 +136,7 @@ func resourceLaceworkIntegrationGhcr() *schema.Resource {
 }
 
 func resourceLaceworkIntegrationGhcrCreate(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	data := api.NewContainerRegistry(d.Get("name").(string),
 		api.GhcrContainerRegistry,
@@ -181,11 +181,11 @@ func resourceLaceworkIntegrationGhcrCreate(d *schema.ResourceData, meta interfac
 }
 
 func resourceLaceworkIntegrationGhcrRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	var response api.GhcrIntegrationResponse
 
 	log.Printf("[INFO] Reading ContVulnCfg integration for %s registry type with guid %s\n",
 		api.GhcrContainerRegistry.String(), d.Id())
-	response, err := lacework.V2.ContainerRegistries.GetGhcr(d.Id())
+	err := getContainerRegistry(meta, d.Id(), &response)
 	if err != nil {
 		return resourceNotFound(d, err)
 	}
@@ -217,7 +217,7 @@ func resourceLaceworkIntegrationGhcrRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceLaceworkIntegrationGhcrUpdate(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	data := api.NewContainerRegistry(d.Get("name").(string),
 		api.GhcrContainerRegistry,
@@ -263,7 +263,7 @@ func resourceLaceworkIntegrationGhcrUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourceLaceworkIntegrationGhcrDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting ContVulnCfg integration for %s registry type with guid %s\n",
 		api.GhcrContainerRegistry.String(), d.Id())
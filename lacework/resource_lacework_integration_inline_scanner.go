@@ -89,7 +89,7 @@ func resourceLaceworkIntegrationInlineScanner() *schema.Resource {
 }
 
 func resourceLaceworkIntegrationInlineScannerCreate(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	data := api.NewContainerRegistry(
 		d.Get("name").(string),
@@ -130,11 +130,11 @@ func resourceLaceworkIntegrationInlineScannerCreate(d *schema.ResourceData, meta
 }
 
 func resourceLaceworkIntegrationInlineScannerRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	var response api.InlineScannerIntegrationResponse
 
 	log.Printf("[INFO] Reading ContVulnCfg integration for %s registry type with guid %s\n",
 		api.InlineScannerContainerRegistry.String(), d.Id())
-	response, err := lacework.V2.ContainerRegistries.GetInlineScanner(d.Id())
+	err := getContainerRegistry(meta, d.Id(), &response)
 	if err != nil {
 		return resourceNotFound(d, err)
 	}
@@ -162,7 +162,7 @@ func resourceLaceworkIntegrationInlineScannerRead(d *schema.ResourceData, meta i
 }
 
 func resourceLaceworkIntegrationInlineScannerUpdate(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	data := api.NewContainerRegistry(d.Get("name").(string),
 		api.InlineScannerContainerRegistry,
@@ -208,7 +208,7 @@ func resourceLaceworkIntegrationInlineScannerUpdate(d *schema.ResourceData, meta
 }
 
 func resourceLaceworkIntegrationInlineScannerDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting ContVulnCfg integration for %s registry type with guid %s\n",
 		api.InlineScannerContainerRegistry.String(), d.Id())
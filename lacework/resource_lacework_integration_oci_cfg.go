@@ -106,7 +106,7 @@ func resourceLaceworkIntegrationOciCfg() *schema.Resource {
 
 func resourceLaceworkIntegrationOciCfgCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		retries  = d.Get("retries").(int)
 		oci      = api.NewCloudAccount(d.Get("name").(string),
 			api.OciCfgCloudAccount,
@@ -165,11 +165,11 @@ func resourceLaceworkIntegrationOciCfgCreate(d *schema.ResourceData, meta interf
 }
 
 func resourceLaceworkIntegrationOciCfgRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	var response api.OciCfgIntegrationResponse
 
 	log.Printf("[INFO] Reading %s integration with guid: %v\n",
 		api.OciCfgCloudAccount.String(), d.Id())
-	response, err := lacework.V2.CloudAccounts.GetOciCfg(d.Id())
+	err := getCloudAccount(meta, d.Id(), &response)
 	if err != nil {
 		return resourceNotFound(d, err)
 	}
@@ -205,7 +205,7 @@ func resourceLaceworkIntegrationOciCfgRead(d *schema.ResourceData, meta interfac
 
 func resourceLaceworkIntegrationOciCfgUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		oci      = api.NewCloudAccount(d.Get("name").(string),
 			api.OciCfgCloudAccount,
 			api.OciCfgData{
@@ -248,7 +248,7 @@ func resourceLaceworkIntegrationOciCfgUpdate(d *schema.ResourceData, meta interf
 }
 
 func resourceLaceworkIntegrationOciCfgDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s integration with guid: %v\n",
 		api.OciCfgCloudAccount.String(), d.Id())
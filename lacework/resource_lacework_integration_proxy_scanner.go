@@ -114,7 +114,7 @@ func resourceLaceworkIntegrationProxyScanner() *schema.Resource {
 }
 
 func resourceLaceworkIntegrationProxyScannerCreate(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	data := api.NewContainerRegistry(
 		d.Get("name").(string),
@@ -159,11 +159,11 @@ func resourceLaceworkIntegrationProxyScannerCreate(d *schema.ResourceData, meta
 }
 
 func resourceLaceworkIntegrationProxyScannerRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	var response api.ProxyScannerIntegrationResponse
 
 	log.Printf("[INFO] Reading ContVulnCfg integration for %s registry type with guid %s\n",
 		api.ProxyScannerContainerRegistry.String(), d.Id())
-	response, err := lacework.V2.ContainerRegistries.GetProxyScanner(d.Id())
+	err := getContainerRegistry(meta, d.Id(), &response)
 	if err != nil {
 		return resourceNotFound(d, err)
 	}
@@ -190,7 +190,7 @@ func resourceLaceworkIntegrationProxyScannerRead(d *schema.ResourceData, meta in
 }
 
 func resourceLaceworkIntegrationProxyScannerUpdate(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	data := api.NewContainerRegistry(d.Get("name").(string),
 		api.ProxyScannerContainerRegistry,
@@ -237,7 +237,7 @@ func resourceLaceworkIntegrationProxyScannerUpdate(d *schema.ResourceData, meta
 }
 
 func resourceLaceworkIntegrationProxyScannerDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting ContVulnCfg integration for %s registry type with guid %s\n",
 		api.ProxyScannerContainerRegistry.String(), d.Id())
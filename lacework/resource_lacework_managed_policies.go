@@ -37,9 +37,7 @@ func resourceLaceworkManagedPolicies() *schema.Resource {
 							Required: true,
 							Description: "The severity for the policy. Valid severities are: " +
 								"Critical, High, Medium, Low, Info",
-							StateFunc: func(val interface{}) string {
-								return strings.TrimSpace(strings.ToLower(val.(string)))
-							},
+							StateFunc:        severityStateFunc,
 							ValidateDiagFunc: ValidSeverity(),
 						},
 					},
@@ -57,7 +55,7 @@ func resourceLaceworkManagedPoliciesCreate(d *schema.ResourceData, meta interfac
 }
 
 func resourceLaceworkManagedPoliciesUpdate(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 	policies, err := getBulkUpdatePolicies(d)
 
 	if err != nil {
@@ -74,7 +72,7 @@ func resourceLaceworkManagedPoliciesUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourceLaceworkManagedPoliciesRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	policiesListResponse, err := lacework.V2.Policy.List()
 
@@ -101,7 +99,7 @@ func resourceLaceworkManagedPoliciesRead(d *schema.ResourceData, meta interface{
 		policySet[i] = map[string]any{}
 		policySet[i]["id"] = bulkUpdatePolicy.PolicyID
 		policySet[i]["enabled"] = policyMap[bulkUpdatePolicy.PolicyID].Enabled
-		policySet[i]["severity"] = policyMap[bulkUpdatePolicy.PolicyID].Severity
+		policySet[i]["severity"] = normalizeSeverity(policyMap[bulkUpdatePolicy.PolicyID].Severity)
 	}
 
 	d.Set("policy", policySet)
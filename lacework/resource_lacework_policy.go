@@ -44,9 +44,7 @@ func resourceLaceworkPolicy() *schema.Resource {
 				Required: true,
 				Description: "The severity for the policy. Valid severities are: " +
 					"Critical, High, Medium, Low, Info",
-				StateFunc: func(val interface{}) string {
-					return strings.TrimSpace(strings.ToLower(val.(string)))
-				},
+				StateFunc:        severityStateFunc,
 				ValidateDiagFunc: ValidSeverity(),
 			},
 			"type": {
@@ -163,7 +161,7 @@ func resourceLaceworkPolicy() *schema.Resource {
 
 func resourceLaceworkPolicyCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 	)
 
 	policy := api.NewPolicy{
@@ -200,7 +198,7 @@ func resourceLaceworkPolicyCreate(d *schema.ResourceData, meta interface{}) erro
 
 func resourceLaceworkPolicyRead(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 	)
 
 	log.Printf("[INFO] Reading Policy with guid %s\n", d.Id())
@@ -215,7 +213,7 @@ func resourceLaceworkPolicyRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("enabled", response.Data.Enabled)
 	d.Set("description", response.Data.Description)
 	d.Set("evaluation", response.Data.EvalFrequency)
-	d.Set("severity", response.Data.Severity)
+	d.Set("severity", normalizeSeverity(response.Data.Severity))
 	d.Set("remediation", response.Data.Remediation)
 	d.Set("limit", response.Data.Limit)
 	d.Set("type", response.Data.PolicyType)
@@ -235,7 +233,7 @@ func resourceLaceworkPolicyRead(d *schema.ResourceData, meta interface{}) error
 
 func resourceLaceworkPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 	)
 
 	if d.HasChange("policy_id_suffix") {
@@ -279,7 +277,7 @@ func resourceLaceworkPolicyUpdate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceLaceworkPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting Policy with guid %s\n", d.Id())
 	_, err := lacework.V2.Policy.Delete(d.Id())
@@ -292,7 +290,7 @@ func resourceLaceworkPolicyDelete(d *schema.ResourceData, meta interface{}) erro
 }
 
 func importLaceworkPolicy(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Importing Lacework Policy with guid: %s\n", d.Id())
 
@@ -43,9 +43,7 @@ func resourceLaceworkPolicyCompliance() *schema.Resource {
 				Required: true,
 				Description: "The severity for the policy. Valid severities are: " +
 					"Critical, High, Medium, Low, Info",
-				StateFunc: func(val interface{}) string {
-					return strings.TrimSpace(strings.ToLower(val.(string)))
-				},
+				StateFunc:        severityStateFunc,
 				ValidateDiagFunc: ValidSeverity(),
 			},
 			"policy_id_suffix": {
@@ -105,7 +103,7 @@ func resourceLaceworkPolicyCompliance() *schema.Resource {
 
 func resourceLaceworkPolicyComplianceCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 	)
 
 	policy := api.NewPolicy{
@@ -139,7 +137,7 @@ func resourceLaceworkPolicyComplianceCreate(d *schema.ResourceData, meta interfa
 
 func resourceLaceworkPolicyComplianceRead(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 	)
 
 	log.Printf("[INFO] Reading Policy with guid %s\n", d.Id())
@@ -153,7 +151,7 @@ func resourceLaceworkPolicyComplianceRead(d *schema.ResourceData, meta interface
 	d.Set("query_id", response.Data.QueryID)
 	d.Set("enabled", response.Data.Enabled)
 	d.Set("description", response.Data.Description)
-	d.Set("severity", response.Data.Severity)
+	d.Set("severity", normalizeSeverity(response.Data.Severity))
 	d.Set("remediation", response.Data.Remediation)
 	d.Set("type", response.Data.PolicyType)
 	d.Set("alerting_enabled", response.Data.AlertEnabled)
@@ -168,7 +166,7 @@ func resourceLaceworkPolicyComplianceRead(d *schema.ResourceData, meta interface
 
 func resourceLaceworkPolicyComplianceUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 	)
 
 	if d.HasChange("policy_id_suffix") {
@@ -206,7 +204,7 @@ func resourceLaceworkPolicyComplianceUpdate(d *schema.ResourceData, meta interfa
 }
 
 func resourceLaceworkPolicyComplianceDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting Policy with guid %s\n", d.Id())
 	_, err := lacework.V2.Policy.Delete(d.Id())
@@ -219,7 +217,7 @@ func resourceLaceworkPolicyComplianceDelete(d *schema.ResourceData, meta interfa
 }
 
 func importLaceworkPolicyCompliance(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Importing Lacework Policy with guid: %s\n", d.Id())
 
@@ -14,10 +14,11 @@ import (
 
 func resourceLaceworkPolicyException() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceLaceworkPolicyExceptionCreate,
-		Read:   resourceLaceworkPolicyExceptionRead,
-		Update: resourceLaceworkPolicyExceptionUpdate,
-		Delete: resourceLaceworkPolicyExceptionDelete,
+		Create:        resourceLaceworkPolicyExceptionCreate,
+		Read:          resourceLaceworkPolicyExceptionRead,
+		Update:        resourceLaceworkPolicyExceptionUpdate,
+		Delete:        resourceLaceworkPolicyExceptionDelete,
+		CustomizeDiff: validateExceptionReview,
 
 		Importer: &schema.ResourceImporter{
 			StateContext: importLaceworkPolicyException,
@@ -34,6 +35,8 @@ func resourceLaceworkPolicyException() *schema.Resource {
 				Required:    true,
 				Description: "The description of the policy exception",
 			},
+			"approved_by": exceptionApprovedBySchema(),
+			"ticket_ref":  exceptionTicketRefSchema(),
 			"constraint": {
 				Type:        schema.TypeSet,
 				MinItems:    1,
@@ -97,7 +100,7 @@ func resourceLaceworkPolicyException() *schema.Resource {
 
 func resourceLaceworkPolicyExceptionCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		policyID = d.Get("policy_id").(string)
 	)
 
@@ -106,7 +109,7 @@ func resourceLaceworkPolicyExceptionCreate(d *schema.ResourceData, meta interfac
 		return err
 	}
 	exception := api.PolicyException{
-		Description: d.Get("description").(string),
+		Description: getExceptionDescription(d),
 		Constraints: constraints,
 	}
 
@@ -126,7 +129,7 @@ func resourceLaceworkPolicyExceptionCreate(d *schema.ResourceData, meta interfac
 
 func resourceLaceworkPolicyExceptionRead(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		response api.PolicyExceptionResponse
 	)
 
@@ -137,7 +140,7 @@ func resourceLaceworkPolicyExceptionRead(d *schema.ResourceData, meta interface{
 	}
 
 	d.SetId(response.Data.ExceptionID)
-	d.Set("description", response.Data.Description)
+	setExceptionDescription(d, response.Data.Description)
 	d.Set("constraint", response.Data.Constraints)
 	d.Set("updated_time", response.Data.LastUpdateTime)
 	d.Set("updated_by", response.Data.LastUpdateUser)
@@ -148,7 +151,7 @@ func resourceLaceworkPolicyExceptionRead(d *schema.ResourceData, meta interface{
 
 func resourceLaceworkPolicyExceptionUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		policyID = d.Get("policy_id").(string)
 	)
 
@@ -158,7 +161,7 @@ func resourceLaceworkPolicyExceptionUpdate(d *schema.ResourceData, meta interfac
 	}
 
 	exception := api.PolicyException{
-		Description: d.Get("description").(string),
+		Description: getExceptionDescription(d),
 		Constraints: constraints,
 		ExceptionID: d.Id(),
 	}
@@ -178,7 +181,7 @@ func resourceLaceworkPolicyExceptionUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourceLaceworkPolicyExceptionDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting Policy with guid %s\n", d.Id())
 	err := lacework.V2.Policy.Exceptions.Delete(d.Get("policy_id").(string), d.Id())
@@ -192,7 +195,7 @@ func resourceLaceworkPolicyExceptionDelete(d *schema.ResourceData, meta interfac
 
 func importLaceworkPolicyException(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	var response api.PolicyExceptionResponse
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Importing Lacework Policy Exception with guid: %s\n", d.Id())
 
@@ -54,7 +54,7 @@ func resourceLaceworkQuery() *schema.Resource {
 
 func resourceLaceworkQueryCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 	)
 
 	query := api.NewQuery{
@@ -80,7 +80,7 @@ func resourceLaceworkQueryCreate(d *schema.ResourceData, meta interface{}) error
 
 func resourceLaceworkQueryRead(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 	)
 
 	log.Printf("[INFO] Reading Query with guid %s\n", d.Id())
@@ -101,7 +101,7 @@ func resourceLaceworkQueryRead(d *schema.ResourceData, meta interface{}) error {
 
 func resourceLaceworkQueryUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 	)
 
 	if d.HasChange("query_id") {
@@ -128,7 +128,7 @@ func resourceLaceworkQueryUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceLaceworkQueryDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting Query with guid %s\n", d.Id())
 	_, err := lacework.V2.Query.Delete(d.Id())
@@ -141,7 +141,7 @@ func resourceLaceworkQueryDelete(d *schema.ResourceData, meta interface{}) error
 }
 
 func importLaceworkQuery(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Importing Lacework Query with guid: %s\n", d.Id())
 
@@ -8,8 +8,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/lacework/go-sdk/api"
-	"golang.org/x/text/cases"
-	"golang.org/x/text/language"
 )
 
 func resourceLaceworkReportRule() *schema.Resource {
@@ -248,22 +246,9 @@ func resourceLaceworkReportRule() *schema.Resource {
 				Description: "List of severities for the report rule. Valid severities are:" +
 					" Critical, High, Medium, Low, Info",
 				Elem: &schema.Schema{
-					Type: schema.TypeString,
-					StateFunc: func(val interface{}) string {
-						return strings.TrimSpace(cases.Title(language.English).String(strings.ToLower(val.(string))))
-					},
-					ValidateFunc: func(value interface{}, key string) ([]string, []error) {
-						switch strings.ToLower(value.(string)) {
-						case "critical", "high", "medium", "low", "info":
-							return nil, nil
-						default:
-							return nil, []error{
-								fmt.Errorf(
-									"%s: can only be 'Critical', 'High', 'Medium', 'Low', 'Info'", key,
-								),
-							}
-						}
-					},
+					Type:             schema.TypeString,
+					StateFunc:        severityTitleStateFunc,
+					ValidateDiagFunc: ValidSeverity(),
 				},
 			},
 			"resource_groups": {
@@ -311,7 +296,7 @@ func resourceLaceworkReportRule() *schema.Resource {
 
 func resourceLaceworkReportRuleCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework       = meta.(*api.Client)
+		lacework       = meta.(*providerMeta).client
 		resourceGroups = d.Get("resource_groups").(*schema.Set).List()
 		severities     = api.NewReportRuleSeverities(castAttributeToStringSlice(d, "severities"))
 		channels       = d.Get("email_alert_channels").(*schema.Set).List()
@@ -355,7 +340,7 @@ func resourceLaceworkReportRuleCreate(d *schema.ResourceData, meta interface{})
 
 func resourceLaceworkReportRuleRead(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		response api.ReportRuleResponse
 	)
 
@@ -384,7 +369,7 @@ func resourceLaceworkReportRuleRead(d *schema.ResourceData, meta interface{}) er
 
 func resourceLaceworkReportRuleUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework       = meta.(*api.Client)
+		lacework       = meta.(*providerMeta).client
 		resourceGroups = d.Get("resource_groups").(*schema.Set).List()
 		severities     = api.NewReportRuleSeverities(castAttributeToStringSlice(d, "severities"))
 		channels       = d.Get("email_alert_channels").(*schema.Set).List()
@@ -430,7 +415,7 @@ func resourceLaceworkReportRuleUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceLaceworkReportRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting report rule with guid %s\n", d.Id())
 	err := lacework.V2.ReportRules.Delete(d.Id())
@@ -444,7 +429,7 @@ func resourceLaceworkReportRuleDelete(d *schema.ResourceData, meta interface{})
 
 func importLaceworkReportRule(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	var response api.ReportRuleResponse
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Importing Lacework Report Rule with guid: %s\n", d.Id())
 
@@ -228,7 +228,7 @@ func populateRgQuery(group *schema.Set, query *api.RGQuery, isTopLevelGroup bool
 }
 
 func resourceLaceworkResourceGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	resourceType := d.Get("type").(string)
 	groupType, isValid := api.FindResourceGroupType(resourceType)
@@ -274,7 +274,7 @@ func resourceLaceworkResourceGroupCreate(d *schema.ResourceData, meta interface{
 }
 
 func resourceLaceworkResourceGroupRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Reading V2 Resource Group with guid %s\n", d.Id())
 	var response api.ResourceGroupResponse
@@ -303,7 +303,7 @@ func resourceLaceworkResourceGroupRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceLaceworkResourceGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	resourceType := d.Get("type").(string)
 	groupType, isValid := api.FindResourceGroupType(resourceType)
@@ -351,7 +351,7 @@ func resourceLaceworkResourceGroupUpdate(d *schema.ResourceData, meta interface{
 }
 
 func resourceLaceworkResourceGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting Resource Group with guid %s\n", d.Id())
 	err := lacework.V2.ResourceGroups.Delete(d.Id())
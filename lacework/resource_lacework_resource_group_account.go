@@ -82,7 +82,7 @@ func resourceLaceworkResourceGroupLwAccount() *schema.Resource {
 }
 
 func resourceLaceworkResourceGroupLwAccountCreate(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	data := api.NewResourceGroup(d.Get("name").(string),
 		api.LwAccountResourceGroup,
@@ -117,7 +117,7 @@ func resourceLaceworkResourceGroupLwAccountCreate(d *schema.ResourceData, meta i
 }
 
 func resourceLaceworkResourceGroupLwAccountRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Reading %s Resource Group with guid %s\n",
 		api.LwAccountResourceGroup.String(), d.Id())
@@ -142,7 +142,7 @@ func resourceLaceworkResourceGroupLwAccountRead(d *schema.ResourceData, meta int
 }
 
 func resourceLaceworkResourceGroupLwAccountUpdate(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	data := api.NewResourceGroup(d.Get("name").(string),
 		api.LwAccountResourceGroup,
@@ -177,7 +177,7 @@ func resourceLaceworkResourceGroupLwAccountUpdate(d *schema.ResourceData, meta i
 }
 
 func resourceLaceworkResourceGroupLwAccountDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s Resource Group with guid %s\n",
 		api.LwAccountResourceGroup.String(), d.Id())
@@ -83,7 +83,7 @@ func resourceLaceworkResourceGroupAws() *schema.Resource {
 }
 
 func resourceLaceworkResourceGroupAwsCreate(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	data := api.NewResourceGroup(d.Get("name").(string),
 		api.AwsResourceGroup,
@@ -118,7 +118,7 @@ func resourceLaceworkResourceGroupAwsCreate(d *schema.ResourceData, meta interfa
 }
 
 func resourceLaceworkResourceGroupAwsRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Reading %s Resource Group with guid %s\n",
 		api.AwsResourceGroup.String(), d.Id())
@@ -143,7 +143,7 @@ func resourceLaceworkResourceGroupAwsRead(d *schema.ResourceData, meta interface
 }
 
 func resourceLaceworkResourceGroupAwsUpdate(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	data := api.NewResourceGroup(d.Get("name").(string),
 		api.AwsResourceGroup,
@@ -178,7 +178,7 @@ func resourceLaceworkResourceGroupAwsUpdate(d *schema.ResourceData, meta interfa
 }
 
 func resourceLaceworkResourceGroupAwsDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s Resource Group with guid %s\n",
 		api.AwsResourceGroup.String(), d.Id())
@@ -88,7 +88,7 @@ func resourceLaceworkResourceGroupAzure() *schema.Resource {
 }
 
 func resourceLaceworkResourceGroupAzureCreate(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	data := api.NewResourceGroup(d.Get("name").(string),
 		api.AzureResourceGroup,
@@ -124,7 +124,7 @@ func resourceLaceworkResourceGroupAzureCreate(d *schema.ResourceData, meta inter
 }
 
 func resourceLaceworkResourceGroupAzureRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Reading %s Resource Group with guid %s\n",
 		api.AzureResourceGroup.String(), d.Id())
@@ -150,7 +150,7 @@ func resourceLaceworkResourceGroupAzureRead(d *schema.ResourceData, meta interfa
 }
 
 func resourceLaceworkResourceGroupAzureUpdate(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	data := api.NewResourceGroup(d.Get("name").(string),
 		api.AzureResourceGroup,
@@ -186,7 +186,7 @@ func resourceLaceworkResourceGroupAzureUpdate(d *schema.ResourceData, meta inter
 }
 
 func resourceLaceworkResourceGroupAzureDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s Resource Group with guid %s\n",
 		api.AzureResourceGroup.String(), d.Id())
@@ -100,7 +100,7 @@ func resourceLaceworkResourceGroupContainer() *schema.Resource {
 }
 
 func resourceLaceworkResourceGroupContainerCreate(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	data := api.NewResourceGroup(d.Get("name").(string),
 		api.ContainerResourceGroup,
@@ -136,7 +136,7 @@ func resourceLaceworkResourceGroupContainerCreate(d *schema.ResourceData, meta i
 }
 
 func resourceLaceworkResourceGroupContainerRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Reading %s Resource Group with guid %s\n",
 		api.ContainerResourceGroup.String(), d.Id())
@@ -162,7 +162,7 @@ func resourceLaceworkResourceGroupContainerRead(d *schema.ResourceData, meta int
 }
 
 func resourceLaceworkResourceGroupContainerUpdate(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	data := api.NewResourceGroup(d.Get("name").(string),
 		api.ContainerResourceGroup,
@@ -198,7 +198,7 @@ func resourceLaceworkResourceGroupContainerUpdate(d *schema.ResourceData, meta i
 }
 
 func resourceLaceworkResourceGroupContainerDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s Resource Group with guid %s\n",
 		api.ContainerResourceGroup.String(), d.Id())
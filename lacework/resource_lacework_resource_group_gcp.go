@@ -88,7 +88,7 @@ func resourceLaceworkResourceGroupGcp() *schema.Resource {
 }
 
 func resourceLaceworkResourceGroupGcpCreate(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	data := api.NewResourceGroup(d.Get("name").(string),
 		api.GcpResourceGroup,
@@ -124,7 +124,7 @@ func resourceLaceworkResourceGroupGcpCreate(d *schema.ResourceData, meta interfa
 }
 
 func resourceLaceworkResourceGroupGcpRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Reading %s Resource Group with guid %s\n",
 		api.GcpResourceGroup.String(), d.Id())
@@ -150,7 +150,7 @@ func resourceLaceworkResourceGroupGcpRead(d *schema.ResourceData, meta interface
 }
 
 func resourceLaceworkResourceGroupGcpUpdate(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	data := api.NewResourceGroup(d.Get("name").(string),
 		api.GcpResourceGroup,
@@ -186,7 +186,7 @@ func resourceLaceworkResourceGroupGcpUpdate(d *schema.ResourceData, meta interfa
 }
 
 func resourceLaceworkResourceGroupGcpDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s Resource Group with guid %s\n",
 		api.GcpResourceGroup.String(), d.Id())
@@ -88,7 +88,7 @@ func resourceLaceworkResourceGroupMachine() *schema.Resource {
 }
 
 func resourceLaceworkResourceGroupMachineCreate(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	data := api.NewResourceGroup(d.Get("name").(string),
 		api.MachineResourceGroup,
@@ -123,7 +123,7 @@ func resourceLaceworkResourceGroupMachineCreate(d *schema.ResourceData, meta int
 }
 
 func resourceLaceworkResourceGroupMachineRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Reading %s Resource Group with guid %s\n",
 		api.MachineResourceGroup.String(), d.Id())
@@ -148,7 +148,7 @@ func resourceLaceworkResourceGroupMachineRead(d *schema.ResourceData, meta inter
 }
 
 func resourceLaceworkResourceGroupMachineUpdate(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	data := api.NewResourceGroup(d.Get("name").(string),
 		api.MachineResourceGroup,
@@ -183,7 +183,7 @@ func resourceLaceworkResourceGroupMachineUpdate(d *schema.ResourceData, meta int
 }
 
 func resourceLaceworkResourceGroupMachineDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s Resource Group with guid %s\n",
 		api.MachineResourceGroup.String(), d.Id())
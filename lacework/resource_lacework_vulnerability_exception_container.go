@@ -18,10 +18,11 @@ import (
 
 func resourceLaceworkVulnerabilityExceptionContainer() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceLaceworkVulnerabilityExceptionContainerCreate,
-		Read:   resourceLaceworkVulnerabilityExceptionContainerRead,
-		Update: resourceLaceworkVulnerabilityExceptionContainerUpdate,
-		Delete: resourceLaceworkVulnerabilityExceptionContainerDelete,
+		Create:        resourceLaceworkVulnerabilityExceptionContainerCreate,
+		Read:          resourceLaceworkVulnerabilityExceptionContainerRead,
+		Update:        resourceLaceworkVulnerabilityExceptionContainerUpdate,
+		Delete:        resourceLaceworkVulnerabilityExceptionContainerDelete,
+		CustomizeDiff: validateExceptionReview,
 
 		Importer: &schema.ResourceImporter{
 			StateContext: importLaceworkVulnerabilityContainerException,
@@ -38,6 +39,8 @@ func resourceLaceworkVulnerabilityExceptionContainer() *schema.Resource {
 				Optional:    true,
 				Description: "The description of the vulnerability exception",
 			},
+			"approved_by": exceptionApprovedBySchema(),
+			"ticket_ref":  exceptionTicketRefSchema(),
 			"expiry": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -107,22 +110,9 @@ func resourceLaceworkVulnerabilityExceptionContainer() *schema.Resource {
 							Description: "List of severities for the vulnerability exception. Valid severities are:" +
 								" Critical, High, Medium, Low, Info",
 							Elem: &schema.Schema{
-								Type: schema.TypeString,
-								StateFunc: func(val interface{}) string {
-									return strings.TrimSpace(cases.Title(language.English).String(strings.ToLower(val.(string))))
-								},
-								ValidateFunc: func(value interface{}, key string) ([]string, []error) {
-									switch strings.ToLower(value.(string)) {
-									case "critical", "high", "medium", "low", "info":
-										return nil, nil
-									default:
-										return nil, []error{
-											fmt.Errorf(
-												"%s: can only be 'Critical', 'High', 'Medium', 'Low', 'Info'", key,
-											),
-										}
-									}
-								},
+								Type:             schema.TypeString,
+								StateFunc:        severityTitleStateFunc,
+								ValidateDiagFunc: ValidSeverity(),
 							},
 						},
 						"fixable_vuln": {
@@ -229,7 +219,7 @@ func resourceLaceworkVulnerabilityExceptionContainer() *schema.Resource {
 
 func resourceLaceworkVulnerabilityExceptionContainerCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework   = meta.(*api.Client)
+		lacework   = meta.(*providerMeta).client
 		severities = castAttributeToStringSlice(d, "vulnerability_criteria.0.severities")
 		packages   = castAttributeToArrayOfCustomKeyValueMap(d, "vulnerability_criteria.0.package", "name", "version")
 		fixable    *bool
@@ -242,7 +232,7 @@ func resourceLaceworkVulnerabilityExceptionContainerCreate(d *schema.ResourceDat
 	}
 
 	vulnExCfg := api.VulnerabilityExceptionConfig{
-		Description:     d.Get("description").(string),
+		Description:     getExceptionDescription(d),
 		Type:            api.VulnerabilityExceptionTypeContainer,
 		ExceptionReason: api.NewVulnerabilityExceptionReason(d.Get("reason").(string)),
 		Severities:      api.NewVulnerabilityExceptionSeverities(severities),
@@ -297,7 +287,7 @@ func resourceLaceworkVulnerabilityExceptionContainerCreate(d *schema.ResourceDat
 
 func resourceLaceworkVulnerabilityExceptionContainerRead(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		response api.VulnerabilityExceptionResponse
 	)
 
@@ -310,7 +300,7 @@ func resourceLaceworkVulnerabilityExceptionContainerRead(d *schema.ResourceData,
 	d.SetId(response.Data.Guid)
 	d.Set("name", response.Data.ExceptionName)
 	d.Set("guid", response.Data.Guid)
-	d.Set("description", response.Data.Props.Description)
+	setExceptionDescription(d, response.Data.Props.Description)
 	d.Set("enabled", response.Data.Enabled == 1)
 	d.Set("created_time", response.Data.CreatedTime)
 	d.Set("updated_time", response.Data.UpdatedTime)
@@ -345,7 +335,7 @@ func resourceLaceworkVulnerabilityExceptionContainerRead(d *schema.ResourceData,
 
 func resourceLaceworkVulnerabilityExceptionContainerUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework   = meta.(*api.Client)
+		lacework   = meta.(*providerMeta).client
 		severities = castAttributeToStringSlice(d, "vulnerability_criteria.0.severities")
 		packages   = castAttributeToArrayOfCustomKeyValueMap(d, "vulnerability_criteria.0.package", "name", "version")
 		fixable    *bool
@@ -357,7 +347,7 @@ func resourceLaceworkVulnerabilityExceptionContainerUpdate(d *schema.ResourceDat
 	}
 
 	vulnExCfg := api.VulnerabilityExceptionConfig{
-		Description:     d.Get("description").(string),
+		Description:     getExceptionDescription(d),
 		Type:            api.VulnerabilityExceptionTypeContainer,
 		ExceptionReason: api.NewVulnerabilityExceptionReason(d.Get("reason").(string)),
 		Severities:      api.NewVulnerabilityExceptionSeverities(severities),
@@ -411,7 +401,7 @@ func resourceLaceworkVulnerabilityExceptionContainerUpdate(d *schema.ResourceDat
 }
 
 func resourceLaceworkVulnerabilityExceptionContainerDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting vulnerability exception with guid %s\n", d.Id())
 	err := lacework.V2.VulnerabilityExceptions.Delete(d.Id())
@@ -424,7 +414,7 @@ func resourceLaceworkVulnerabilityExceptionContainerDelete(d *schema.ResourceDat
 }
 
 func importLaceworkVulnerabilityContainerException(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Importing Lacework Vulnerability Exception with guid: %s\n", d.Id())
 
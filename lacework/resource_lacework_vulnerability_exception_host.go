@@ -18,10 +18,11 @@ import (
 
 func resourceLaceworkVulnerabilityExceptionHost() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceLaceworkVulnerabilityExceptionHostCreate,
-		Read:   resourceLaceworkVulnerabilityExceptionHostRead,
-		Update: resourceLaceworkVulnerabilityExceptionHostUpdate,
-		Delete: resourceLaceworkVulnerabilityExceptionHostDelete,
+		Create:        resourceLaceworkVulnerabilityExceptionHostCreate,
+		Read:          resourceLaceworkVulnerabilityExceptionHostRead,
+		Update:        resourceLaceworkVulnerabilityExceptionHostUpdate,
+		Delete:        resourceLaceworkVulnerabilityExceptionHostDelete,
+		CustomizeDiff: validateExceptionReview,
 
 		Importer: &schema.ResourceImporter{
 			StateContext: importLaceworkVulnerabilityHostException,
@@ -38,6 +39,8 @@ func resourceLaceworkVulnerabilityExceptionHost() *schema.Resource {
 				Optional:    true,
 				Description: "The description of the vulnerability exception",
 			},
+			"approved_by": exceptionApprovedBySchema(),
+			"ticket_ref":  exceptionTicketRefSchema(),
 			"expiry": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -108,22 +111,9 @@ func resourceLaceworkVulnerabilityExceptionHost() *schema.Resource {
 							Description: "List of severities for the vulnerability exception. Valid severities are:" +
 								" Critical, High, Medium, Low, Info",
 							Elem: &schema.Schema{
-								Type: schema.TypeString,
-								StateFunc: func(val interface{}) string {
-									return strings.TrimSpace(cases.Title(language.English).String(strings.ToLower(val.(string))))
-								},
-								ValidateFunc: func(value interface{}, key string) ([]string, []error) {
-									switch strings.ToLower(value.(string)) {
-									case "critical", "high", "medium", "low", "info":
-										return nil, nil
-									default:
-										return nil, []error{
-											fmt.Errorf(
-												"%s: can only be 'Critical', 'High', 'Medium', 'Low', 'Info'", key,
-											),
-										}
-									}
-								},
+								Type:             schema.TypeString,
+								StateFunc:        severityTitleStateFunc,
+								ValidateDiagFunc: ValidSeverity(),
 							},
 						},
 						"fixable_vuln": {
@@ -219,7 +209,7 @@ func resourceLaceworkVulnerabilityExceptionHost() *schema.Resource {
 
 func resourceLaceworkVulnerabilityExceptionHostCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework   = meta.(*api.Client)
+		lacework   = meta.(*providerMeta).client
 		severities = castAttributeToStringSlice(d, "vulnerability_criteria.0.severities")
 		fixable    *bool
 		packages   = castAttributeToArrayOfCustomKeyValueMap(d, "vulnerability_criteria.0.package", "name", "version")
@@ -232,7 +222,7 @@ func resourceLaceworkVulnerabilityExceptionHostCreate(d *schema.ResourceData, me
 	}
 
 	vulnExCfg := api.VulnerabilityExceptionConfig{
-		Description:     d.Get("description").(string),
+		Description:     getExceptionDescription(d),
 		Type:            api.VulnerabilityExceptionTypeHost,
 		ExceptionReason: api.NewVulnerabilityExceptionReason(d.Get("reason").(string)),
 		Severities:      api.NewVulnerabilityExceptionSeverities(severities),
@@ -286,7 +276,7 @@ func resourceLaceworkVulnerabilityExceptionHostCreate(d *schema.ResourceData, me
 
 func resourceLaceworkVulnerabilityExceptionHostRead(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 	)
 
 	log.Printf("[INFO] Reading vulnerability exception with guid %s\n", d.Id())
@@ -298,7 +288,7 @@ func resourceLaceworkVulnerabilityExceptionHostRead(d *schema.ResourceData, meta
 	d.SetId(response.Data.Guid)
 	d.Set("name", response.Data.ExceptionName)
 	d.Set("guid", response.Data.Guid)
-	d.Set("description", response.Data.Props.Description)
+	setExceptionDescription(d, response.Data.Props.Description)
 	d.Set("enabled", response.Data.Enabled == 1)
 	d.Set("created_time", response.Data.CreatedTime)
 	d.Set("updated_time", response.Data.UpdatedTime)
@@ -334,7 +324,7 @@ func resourceLaceworkVulnerabilityExceptionHostRead(d *schema.ResourceData, meta
 
 func resourceLaceworkVulnerabilityExceptionHostUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework   = meta.(*api.Client)
+		lacework   = meta.(*providerMeta).client
 		fixable    *bool
 		severities = castAttributeToStringSlice(d, "vulnerability_criteria.0.severities")
 		packages   = castAttributeToArrayOfCustomKeyValueMap(d, "vulnerability_criteria.0.package", "name", "version")
@@ -346,7 +336,7 @@ func resourceLaceworkVulnerabilityExceptionHostUpdate(d *schema.ResourceData, me
 	}
 
 	vulnExCfg := api.VulnerabilityExceptionConfig{
-		Description:     d.Get("description").(string),
+		Description:     getExceptionDescription(d),
 		Type:            api.VulnerabilityExceptionTypeHost,
 		ExceptionReason: api.NewVulnerabilityExceptionReason(d.Get("reason").(string)),
 		Severities:      api.NewVulnerabilityExceptionSeverities(severities),
@@ -399,7 +389,7 @@ func resourceLaceworkVulnerabilityExceptionHostUpdate(d *schema.ResourceData, me
 }
 
 func resourceLaceworkVulnerabilityExceptionHostDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting vulnerability exception with guid %s\n", d.Id())
 	err := lacework.V2.VulnerabilityExceptions.Delete(d.Id())
@@ -412,7 +402,7 @@ func resourceLaceworkVulnerabilityExceptionHostDelete(d *schema.ResourceData, me
 }
 
 func importLaceworkVulnerabilityHostException(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Importing Lacework Vulnerability Exception with guid: %s\n", d.Id())
 
@@ -9,17 +9,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// ValidSeverity returns a SchemaValidateDiagFunc which validates that the
+// value is a severity, case insensitive.
 func ValidSeverity() schema.SchemaValidateDiagFunc {
 	return validation.ToDiagFunc(func(value interface{}, key string) ([]string, []error) {
-		switch strings.ToLower(value.(string)) {
-		case "critical", "high", "medium", "low", "info":
+		if newSeverity(value.(string)).Valid() {
 			return nil, nil
-		default:
-			return nil, []error{
-				fmt.Errorf(
-					"%s: can only be 'Critical', 'High', 'Medium', 'Low', 'Info'", key,
-				),
-			}
+		}
+		return nil, []error{
+			fmt.Errorf(
+				"%s: can only be 'Critical', 'High', 'Medium', 'Low', 'Info'", key,
+			),
 		}
 	})
 }
@@ -61,3 +61,22 @@ func ValidateTimeFormat(timeFormat string) schema.SchemaValidateDiagFunc {
 		return
 	})
 }
+
+// ValidDuration returns a SchemaValidateDiagFunc which validates that the
+// value is a positive duration, like 30m or 72h.
+func ValidDuration() schema.SchemaValidateDiagFunc {
+	return validation.ToDiagFunc(func(i interface{}, k string) (warnings []string, errors []error) {
+		v, ok := i.(string)
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+			return
+		}
+
+		duration, err := time.ParseDuration(v)
+		if err != nil || duration <= 0 {
+			errors = append(errors, fmt.Errorf("expected %s to be a positive duration like 30m or 72h, got %s", k, v))
+			return
+		}
+		return
+	})
+}
@@ -0,0 +1,106 @@
+package lacework
+
+import (
+	"strings"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+)
+
+// severity is a Lacework severity in its canonical lower case form, the Lacework APIs
+// are not consistent on the case of severities, so every severity sent by users or
+// returned by the APIs should go through newSeverity
+type severity string
+
+const (
+	severityCritical severity = "critical"
+	severityHigh     severity = "high"
+	severityMedium   severity = "medium"
+	severityLow      severity = "low"
+	severityInfo     severity = "info"
+	severityUnknown  severity = "unknown"
+)
+
+// validSeverities is the list of valid severities, from the most to the least critical
+var validSeverities = []severity{severityCritical, severityHigh, severityMedium, severityLow, severityInfo}
+
+// newSeverity returns the severity of the provided value, case insensitive, values
+// that are not a valid severity return severityUnknown
+func newSeverity(value string) severity {
+	s := severity(strings.ToLower(strings.TrimSpace(value)))
+	for _, valid := range validSeverities {
+		if s == valid {
+			return s
+		}
+	}
+	return severityUnknown
+}
+
+func (s severity) String() string {
+	return string(s)
+}
+
+// Title returns the severity in title case, the format used by alert rules, report rules
+// and vulnerability exceptions, for example 'Critical'
+func (s severity) Title() string {
+	return cases.Title(language.English).String(string(s))
+}
+
+func (s severity) Valid() bool {
+	return s != severityUnknown
+}
+
+// Order returns the position of the severity from the most critical, starting at 1,
+// unknown severities are the least critical
+func (s severity) Order() int {
+	for i, valid := range validSeverities {
+		if s == valid {
+			return i + 1
+		}
+	}
+	return len(validSeverities) + 1
+}
+
+// normalizeSeverity returns a severity returned by the Lacework APIs in lower case, values
+// that are not a valid severity are kept as they are, in lower case, instead of 'unknown'
+func normalizeSeverity(value string) string {
+	if s := newSeverity(value); s.Valid() {
+		return s.String()
+	}
+	return strings.ToLower(strings.TrimSpace(value))
+}
+
+// severityIn returns true if the value has one of the provided severities, case insensitive
+func severityIn(severities []string, value string) bool {
+	s := newSeverity(value)
+	if !s.Valid() {
+		return false
+	}
+	for _, other := range severities {
+		if newSeverity(other) == s {
+			return true
+		}
+	}
+	return false
+}
+
+// highestSeverity returns the most critical of the provided severities
+func highestSeverity(severities ...string) severity {
+	highest := severityUnknown
+	for _, value := range severities {
+		if s := newSeverity(value); s.Order() < highest.Order() {
+			highest = s
+		}
+	}
+	return highest
+}
+
+// severityStateFunc stores severities in lower case, like the policies API
+func severityStateFunc(val interface{}) string {
+	return newSeverity(val.(string)).String()
+}
+
+// severityTitleStateFunc stores severities in title case, like the alert rules API
+func severityTitleStateFunc(val interface{}) string {
+	return newSeverity(val.(string)).Title()
+}
@@ -0,0 +1,49 @@
+package lacework
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSeverity(t *testing.T) {
+	assert.Equal(t, severityCritical, newSeverity("Critical"))
+	assert.Equal(t, severityHigh, newSeverity(" HIGH "))
+	assert.Equal(t, severityInfo, newSeverity("info"))
+	assert.Equal(t, severityUnknown, newSeverity("severe"))
+	assert.Equal(t, severityUnknown, newSeverity(""))
+
+	assert.True(t, newSeverity("medium").Valid())
+	assert.False(t, newSeverity("Unknown").Valid())
+
+	assert.Equal(t, "low", newSeverity("LOW").String())
+	assert.Equal(t, "Low", newSeverity("LOW").Title())
+}
+
+func TestNormalizeSeverity(t *testing.T) {
+	assert.Equal(t, "critical", normalizeSeverity("Critical"))
+	assert.Equal(t, "info", normalizeSeverity(" INFO "))
+	assert.Equal(t, "severe", normalizeSeverity("Severe"), "invalid severities are not hidden")
+	assert.Equal(t, "", normalizeSeverity(""))
+}
+
+func TestSeverityOrder(t *testing.T) {
+	assert.Equal(t, 1, severityCritical.Order())
+	assert.Equal(t, 5, severityInfo.Order())
+	assert.Equal(t, 6, severityUnknown.Order())
+
+	assert.Equal(t, severityHigh, highestSeverity("low", "High", "info"))
+	assert.Equal(t, severityInfo, highestSeverity("Unknown", "info"))
+	assert.Equal(t, severityUnknown, highestSeverity())
+}
+
+func TestSeverityIn(t *testing.T) {
+	assert.True(t, severityIn([]string{"critical", "High"}, "HIGH"))
+	assert.False(t, severityIn([]string{"critical", "High"}, "medium"))
+	assert.False(t, severityIn([]string{"unknown"}, "unknown"), "unknown severities never match")
+}
+
+func TestSeverityStateFuncs(t *testing.T) {
+	assert.Equal(t, "critical", severityStateFunc(" Critical"))
+	assert.Equal(t, "Critical", severityTitleStateFunc("CRITICAL "))
+}